@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AccountOptions holds the account-wide options. Nil fields are left
+// unchanged when updating.
+type AccountOptions struct {
+	ClickTracking        *bool `json:"click_tracking,omitempty"`
+	OpenTracking         *bool `json:"open_tracking,omitempty"`
+	RestTrackingDefault  *bool `json:"rest_tracking_default,omitempty"`
+	SMTPTrackingDefault  *bool `json:"smtp_tracking_default,omitempty"`
+	TransactionalUnsub   *bool `json:"transactional_unsub,omitempty"`
+	TransactionalDefault *bool `json:"transactional_default,omitempty"`
+	EnforceSSO           *bool `json:"enforce_sso,omitempty"`
+}
+
+type AccountSubscription struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	PlanVolume int    `json:"plan_volume"`
+}
+
+type AccountUsagePeriod struct {
+	Used  int    `json:"used"`
+	Limit int    `json:"limit"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type AccountUsage struct {
+	Day   AccountUsagePeriod `json:"day"`
+	Month AccountUsagePeriod `json:"month"`
+}
+
+type Account struct {
+	CustomerID   int                 `json:"customer_id"`
+	CompanyName  string              `json:"company_name"`
+	Status       string              `json:"status"`
+	CountryCode  string              `json:"country_code"`
+	Created      string              `json:"created"`
+	Subscription AccountSubscription `json:"subscription"`
+	Options      AccountOptions      `json:"options"`
+	Usage        *AccountUsage       `json:"usage,omitempty"`
+
+	// Features holds every boolean option of the account, including the
+	// ones without a dedicated field in AccountOptions
+	Features map[string]bool `json:"-"`
+}
+
+// DefaultAccountOptions are the values SparkPost assigns to a new account
+func DefaultAccountOptions() AccountOptions {
+	enabled, disabled := true, false
+	return AccountOptions{
+		ClickTracking:        &enabled,
+		OpenTracking:         &enabled,
+		RestTrackingDefault:  &enabled,
+		SMTPTrackingDefault:  &disabled,
+		TransactionalUnsub:   &disabled,
+		TransactionalDefault: &disabled,
+	}
+}
+
+func (c *SparkPostClient) GetAccount(includeUsage bool) (*Account, error) {
+	endpoint := "account"
+	if includeUsage {
+		endpoint = "account?include=usage"
+	}
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return nil, fmt.Errorf("get account request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results json.RawMessage `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get account response: %w", err)
+	}
+
+	var account Account
+	if err := json.Unmarshal(respBody.Results, &account); err != nil {
+		return nil, fmt.Errorf("failed to parse get account response: %w", err)
+	}
+
+	var raw struct {
+		Options map[string]interface{} `json:"options"`
+	}
+	if err := json.Unmarshal(respBody.Results, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse account options: %w", err)
+	}
+
+	account.Features = map[string]bool{}
+	for name, value := range raw.Options {
+		if enabled, ok := value.(bool); ok {
+			account.Features[name] = enabled
+		}
+	}
+
+	return &account, nil
+}
+
+func (c *SparkPostClient) UpdateAccountOptions(options AccountOptions) error {
+	body := map[string]interface{}{
+		"options": options,
+	}
+
+	req, err := c.newRequest("PUT", "account", body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update account request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
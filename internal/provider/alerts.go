@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type AlertThresholdEvaluator struct {
+	Source   string  `json:"source"`
+	Operator string  `json:"operator"`
+	Value    float64 `json:"value"`
+}
+
+type AlertFilter struct {
+	FilterType   string   `json:"filter_type"`
+	FilterValues []string `json:"filter_values"`
+}
+
+type AlertWebhookChannel struct {
+	Target string `json:"target"`
+}
+
+type AlertSlackChannel struct {
+	Target string `json:"target"`
+}
+
+type AlertChannels struct {
+	Emails  []string             `json:"emails,omitempty"`
+	Webhook *AlertWebhookChannel `json:"webhook,omitempty"`
+	Slack   *AlertSlackChannel   `json:"slack,omitempty"`
+}
+
+type Alert struct {
+	ID                 int                     `json:"id,omitempty"`
+	Name               string                  `json:"name"`
+	Metric             string                  `json:"metric"`
+	ThresholdEvaluator AlertThresholdEvaluator `json:"threshold_evaluator"`
+	Filters            []AlertFilter           `json:"filters"`
+	Subaccounts        []int                   `json:"subaccounts,omitempty"`
+	Channels           AlertChannels           `json:"channels"`
+	Muted              bool                    `json:"muted"`
+}
+
+func (c *SparkPostClient) CreateAlert(alert Alert) (int, error) {
+	req, err := c.newRequest("POST", "alerts", alert)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return 0, fmt.Errorf("create alert request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results struct {
+			ID int `json:"id"`
+		} `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return 0, fmt.Errorf("failed to parse create alert response: %w", err)
+	}
+
+	return respBody.Results.ID, nil
+}
+
+func (c *SparkPostClient) GetAlert(id int) (*Alert, error) {
+	endpoint := fmt.Sprintf("alerts/%d", id)
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, AlertNotFound
+		}
+		return nil, fmt.Errorf("get alert request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results Alert `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get alert response: %w", err)
+	}
+
+	return &respBody.Results, nil
+}
+
+func (c *SparkPostClient) UpdateAlert(alert Alert) error {
+	endpoint := fmt.Sprintf("alerts/%d", alert.ID)
+
+	// The ID is taken from the endpoint and must not be sent in the body
+	body := alert
+	body.ID = 0
+
+	req, err := c.newRequest("PUT", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update alert request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) DeleteAlert(id int) error {
+	endpoint := fmt.Sprintf("alerts/%d", id)
+
+	req, err := c.newRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return AlertNotFound
+		}
+		return fmt.Errorf("delete alert request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+var AlertNotFound = fmt.Errorf("alert not found")
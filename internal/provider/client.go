@@ -2,25 +2,44 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 type SparkPostClient struct {
 	APIUrl string
 	APIKey string
-	client *http.Client
+	// Subaccount is the provider-level default subaccount ID, or 0 for the
+	// primary account. Subaccount-aware resources and data sources fall back
+	// to it when they don't set a subaccount themselves
+	Subaccount int
+	// Resolver resolves the DNS records checked before verification
+	Resolver DNSLookup
+	client   *http.Client
+	ctx      context.Context
 }
 
 func NewSparkPostClient(apiUrl string, apiKey string) *SparkPostClient {
 	return &SparkPostClient{
-		APIUrl: apiUrl,
-		APIKey: apiKey,
-		client: &http.Client{},
+		APIUrl:   apiUrl,
+		APIKey:   apiKey,
+		Resolver: NewDNSResolver(""),
+		client:   &http.Client{Timeout: requestTimeout},
 	}
 }
 
+// WithContext returns a copy of the client whose requests are bound to ctx,
+// so they are cancelled once ctx is done or its deadline passes
+func (c *SparkPostClient) WithContext(ctx context.Context) *SparkPostClient {
+	client := *c
+	client.ctx = ctx
+	return &client
+}
+
 func (c *SparkPostClient) newRequest(method, endpoint string, body interface{}) (*http.Request, error) {
 	var bodyBytes []byte
 	var err error
@@ -34,7 +53,12 @@ func (c *SparkPostClient) newRequest(method, endpoint string, body interface{})
 		}
 	}
 
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(bodyBytes))
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		return nil, err
 	}
@@ -58,3 +82,13 @@ func (c *SparkPostClient) doRequest(req *http.Request, expectedCode int) (*http.
 
 	return resp, nil
 }
+
+// Region returns "eu" when the client talks to the SparkPost EU API and "us"
+// otherwise
+func (c *SparkPostClient) Region() string {
+	u, err := url.Parse(c.APIUrl)
+	if err == nil && strings.HasSuffix(u.Hostname(), "eu.sparkpost.com") {
+		return "eu"
+	}
+	return "us"
+}
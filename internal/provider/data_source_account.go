@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &accountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+type accountDataSource struct {
+	client *SparkPostClient
+}
+
+type accountDataSourceModel struct {
+	CustomerID   types.Int64              `tfsdk:"customer_id"`
+	CompanyName  types.String             `tfsdk:"company_name"`
+	Status       types.String             `tfsdk:"status"`
+	CountryCode  types.String             `tfsdk:"country_code"`
+	Created      types.String             `tfsdk:"created"`
+	Region       types.String             `tfsdk:"region"`
+	Subscription accountSubscriptionModel `tfsdk:"subscription"`
+	Usage        accountUsageModel        `tfsdk:"usage"`
+	Features     map[string]bool          `tfsdk:"features"`
+}
+
+type accountSubscriptionModel struct {
+	Code       types.String `tfsdk:"code"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	PlanVolume types.Int64  `tfsdk:"plan_volume"`
+}
+
+type accountUsageModel struct {
+	DailyUsed    types.Int64 `tfsdk:"daily_used"`
+	DailyLimit   types.Int64 `tfsdk:"daily_limit"`
+	MonthlyUsed  types.Int64 `tfsdk:"monthly_used"`
+	MonthlyLimit types.Int64 `tfsdk:"monthly_limit"`
+}
+
+func (d *accountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *accountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The customer ID of the account",
+			},
+			"company_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The company name of the account",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the account, such as `active` or `suspended`",
+			},
+			"country_code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The country code of the account",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the account was created",
+			},
+			"region": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SparkPost region of the account, either `us` or `eu`, based on the provider `api_url`",
+			},
+			"subscription": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The plan the account is subscribed to",
+				Attributes: map[string]schema.Attribute{
+					"code": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						Computed: true,
+					},
+					"plan_volume": schema.Int64Attribute{
+						Computed: true,
+					},
+				},
+			},
+			"usage": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sending usage and limits of the account",
+				Attributes: map[string]schema.Attribute{
+					"daily_used": schema.Int64Attribute{
+						Computed: true,
+					},
+					"daily_limit": schema.Int64Attribute{
+						Computed: true,
+					},
+					"monthly_used": schema.Int64Attribute{
+						Computed: true,
+					},
+					"monthly_limit": schema.Int64Attribute{
+						Computed: true,
+					},
+				},
+			},
+			"features": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.BoolType,
+				MarkdownDescription: "The boolean options of the account keyed by name, showing which features are enabled",
+			},
+		},
+	}
+}
+
+func (d *accountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	account, err := client.GetAccount(true)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch account", fmt.Sprintf("Error: %s", err))
+		return
+	}
+
+	usage := AccountUsage{}
+	if account.Usage != nil {
+		usage = *account.Usage
+	}
+
+	state := accountDataSourceModel{
+		CustomerID:  types.Int64Value(int64(account.CustomerID)),
+		CompanyName: types.StringValue(account.CompanyName),
+		Status:      types.StringValue(account.Status),
+		CountryCode: types.StringValue(account.CountryCode),
+		Created:     types.StringValue(account.Created),
+		Region:      types.StringValue(client.Region()),
+		Subscription: accountSubscriptionModel{
+			Code:       types.StringValue(account.Subscription.Code),
+			Name:       types.StringValue(account.Subscription.Name),
+			Type:       types.StringValue(account.Subscription.Type),
+			PlanVolume: types.Int64Value(int64(account.Subscription.PlanVolume)),
+		},
+		Usage: accountUsageModel{
+			DailyUsed:    types.Int64Value(int64(usage.Day.Used)),
+			DailyLimit:   types.Int64Value(int64(usage.Day.Limit)),
+			MonthlyUsed:  types.Int64Value(int64(usage.Month.Used)),
+			MonthlyLimit: types.Int64Value(int64(usage.Month.Limit)),
+		},
+		Features: account.Features,
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
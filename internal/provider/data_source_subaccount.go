@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &subaccountDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &subaccountDataSource{}
+
+func NewSubaccountDataSource() datasource.DataSource {
+	return &subaccountDataSource{}
+}
+
+type subaccountDataSource struct {
+	client *SparkPostClient
+}
+
+type subaccountDataSourceModel struct {
+	Id                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	NameRegex           types.String `tfsdk:"name_regex"`
+	Status              types.String `tfsdk:"status"`
+	ComplianceStatus    types.String `tfsdk:"compliance_status"`
+	IPPool              types.String `tfsdk:"ip_pool"`
+	Created             types.String `tfsdk:"created"`
+	DailySendingLimit   types.Int64  `tfsdk:"daily_sending_limit"`
+	MonthlySendingLimit types.Int64  `tfsdk:"monthly_sending_limit"`
+}
+
+func (d *subaccountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount"
+}
+
+func (d *subaccountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single subaccount by ID, exact name or name regex",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the subaccount. Exactly one of id, name or name_regex must be set",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The exact name of the subaccount. Exactly one of id, name or name_regex must be set",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A regular expression that must match the name of exactly one subaccount. Exactly one of id, name or name_regex must be set",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the subaccount, such as `active`, `suspended` or `terminated`",
+			},
+			"compliance_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The compliance status of the subaccount as set by SparkPost",
+			},
+			"ip_pool": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The default IP pool for messages sent by the subaccount",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the subaccount was created",
+			},
+			"daily_sending_limit": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The maximum number of messages the subaccount can send per day, if limited",
+			},
+			"monthly_sending_limit": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The maximum number of messages the subaccount can send per month, if limited",
+			},
+		},
+	}
+}
+
+func (d *subaccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *subaccountDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config subaccountDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values may still turn out to be null
+	if config.Id.IsUnknown() || config.Name.IsUnknown() || config.NameRegex.IsUnknown() {
+		return
+	}
+
+	set := 0
+	for _, isNull := range []bool{config.Id.IsNull(), config.Name.IsNull(), config.NameRegex.IsNull()} {
+		if !isNull {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"Exactly one of 'id', 'name' or 'name_regex' must be set.",
+		)
+	}
+
+	if !config.NameRegex.IsNull() {
+		if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Configuration",
+				fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+			)
+		}
+	}
+}
+
+func (d *subaccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	var config subaccountDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subaccount *Subaccount
+	if !config.Id.IsNull() {
+		sa, err := client.GetSubaccount(int(config.Id.ValueInt64()))
+		if err == SubaccountNotFound {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Subaccount Not Found",
+				fmt.Sprintf("No subaccount has the ID %d", config.Id.ValueInt64()),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch subaccount", fmt.Sprintf("Error: %s", err))
+			return
+		}
+		subaccount = sa
+	} else {
+		subaccounts, err := client.ListSubaccounts()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch subaccounts", fmt.Sprintf("Error: %s", err))
+			return
+		}
+
+		attribute := path.Root("name")
+		pattern := "^" + regexp.QuoteMeta(config.Name.ValueString()) + "$"
+		if !config.NameRegex.IsNull() {
+			attribute = path.Root("name_regex")
+			pattern = config.NameRegex.ValueString()
+		}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				attribute,
+				"Invalid Configuration",
+				fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+			)
+			return
+		}
+
+		matches := FilterSubaccounts(subaccounts, re, "")
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(attribute, "Subaccount Not Found", "No subaccount matches the given name")
+			return
+		case 1:
+			subaccount = &matches[0]
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, sa := range matches {
+				ids = append(ids, strconv.Itoa(sa.ID))
+			}
+			resp.Diagnostics.AddAttributeError(
+				attribute,
+				"Multiple Subaccounts Found",
+				fmt.Sprintf("%d subaccounts match the given name (IDs %s). Use 'id' or a more specific name instead.", len(matches), strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	state := subaccountDataSourceModel{
+		Id:                  types.Int64Value(int64(subaccount.ID)),
+		Name:                types.StringValue(subaccount.Name),
+		NameRegex:           config.NameRegex,
+		Status:              types.StringValue(subaccount.Status),
+		ComplianceStatus:    types.StringValue(subaccount.ComplianceStatus),
+		IPPool:              types.StringValue(subaccount.IPPool),
+		Created:             types.StringValue(subaccount.Created),
+		DailySendingLimit:   types.Int64Null(),
+		MonthlySendingLimit: types.Int64Null(),
+	}
+	if limits := subaccount.Options.SendingLimits; limits != nil {
+		if limits.Daily != nil {
+			state.DailySendingLimit = types.Int64Value(int64(*limits.Daily))
+		}
+		if limits.Monthly != nil {
+			state.MonthlySendingLimit = types.Int64Value(int64(*limits.Monthly))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -11,6 +12,7 @@ import (
 )
 
 var _ datasource.DataSource = &subaccountsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &subaccountsDataSource{}
 
 func NewSubAccountsDataSource() datasource.DataSource {
 	return &subaccountsDataSource{}
@@ -28,6 +30,14 @@ func (d *subaccountsDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *subaccountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
     resp.Schema = schema.Schema{
 	    Attributes: map[string]schema.Attribute{
+		    "name_regex": schema.StringAttribute{
+			    Optional:            true,
+			    MarkdownDescription: "Optional regular expression the subaccount names must match",
+		    },
+		    "status": schema.StringAttribute{
+			    Optional:            true,
+			    MarkdownDescription: "Optional status the subaccounts must have, such as `active`, `suspended` or `terminated`",
+		    },
 		    "subaccounts": schema.ListNestedAttribute{
 			    Computed: true,
 			    NestedObject: schema.NestedAttributeObject{
@@ -38,6 +48,15 @@ func (d *subaccountsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 					    "name": schema.StringAttribute{
 						    Computed: true,
 					    },
+					    "status": schema.StringAttribute{
+						    Computed: true,
+					    },
+					    "compliance_status": schema.StringAttribute{
+						    Computed: true,
+					    },
+					    "ip_pool": schema.StringAttribute{
+						    Computed: true,
+					    },
 				    },
 			    },
 		    },
@@ -61,23 +80,68 @@ func (d *subaccountsDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 
+func (d *subaccountsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+    var nameRegex types.String
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+    if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+        return
+    }
+
+    if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+        resp.Diagnostics.AddAttributeError(
+            path.Root("name_regex"),
+            "Invalid Configuration",
+            fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+        )
+    }
+}
+
 func (d *subaccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-    subaccounts, err := d.client.ListSubaccounts()
+    client := d.client.WithContext(ctx)
+
+    var nameRegex, status types.String
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &status)...)
+    if resp.Diagnostics.HasError() {
+        return
+    }
+
+    subaccounts, err := client.ListSubaccounts()
     if err != nil {
         resp.Diagnostics.AddError("Failed to fetch subaccounts", fmt.Sprintf("Error: %s", err))
         return
     }
 
+    var re *regexp.Regexp
+    if !nameRegex.IsNull() {
+        re, err = regexp.Compile(nameRegex.ValueString())
+        if err != nil {
+            resp.Diagnostics.AddAttributeError(
+                path.Root("name_regex"),
+                "Invalid Configuration",
+                fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err),
+            )
+            return
+        }
+    }
+    subaccounts = FilterSubaccounts(subaccounts, re, status.ValueString())
+
     var objs []attr.Value
     attrTypes := map[string]attr.Type{
-        "id":   types.Int64Type,
-        "name": types.StringType,
+        "id":                types.Int64Type,
+        "name":              types.StringType,
+        "status":            types.StringType,
+        "compliance_status": types.StringType,
+        "ip_pool":           types.StringType,
     }
 
     for _, sa := range subaccounts {
         obj, diag := types.ObjectValue(attrTypes, map[string]attr.Value{
-            "id":   types.Int64Value(int64(sa.ID)),
-            "name": types.StringValue(sa.Name),
+            "id":                types.Int64Value(int64(sa.ID)),
+            "name":              types.StringValue(sa.Name),
+            "status":            types.StringValue(sa.Status),
+            "compliance_status": types.StringValue(sa.ComplianceStatus),
+            "ip_pool":           types.StringValue(sa.IPPool),
         })
         if diag.HasError() {
             resp.Diagnostics.Append(diag...)
@@ -94,6 +158,8 @@ func (d *subaccountsDataSource) Read(ctx context.Context, req datasource.ReadReq
         return
     }
 
+    resp.State.SetAttribute(ctx, path.Root("name_regex"), nameRegex)
+    resp.State.SetAttribute(ctx, path.Root("status"), status)
     resp.State.SetAttribute(ctx, path.Root("subaccounts"), listVal)
 }
 
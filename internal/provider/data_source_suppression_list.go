@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &suppressionListDataSource{}
+
+func NewSuppressionListDataSource() datasource.DataSource {
+	return &suppressionListDataSource{}
+}
+
+type suppressionListDataSource struct {
+	client *SparkPostClient
+}
+
+type suppressionListDataSourceModel struct {
+	Recipient    types.String `tfsdk:"recipient"`
+	Type         types.String `tfsdk:"type"`
+	Sources      types.List   `tfsdk:"sources"`
+	From         types.String `tfsdk:"from"`
+	To           types.String `tfsdk:"to"`
+	Domain       types.String `tfsdk:"domain"`
+	Subaccount   types.Int64  `tfsdk:"subaccount"`
+	Suppressions types.List   `tfsdk:"suppressions"`
+}
+
+var suppressionAttrTypes = map[string]attr.Type{
+	"recipient":   types.StringType,
+	"type":        types.StringType,
+	"description": types.StringType,
+	"source":      types.StringType,
+	"created":     types.StringType,
+	"updated":     types.StringType,
+}
+
+func (d *suppressionListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suppression_list"
+}
+
+func (d *suppressionListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"recipient": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional email address to look up. The other filters except type are ignored when set",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional suppression type to filter by. Either `transactional` or `non_transactional`",
+			},
+			"sources": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Optional sources to filter by, such as `Spam Complaint` or `Manually Added`",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional start of the date range the entries were last updated in, in the format `YYYY-MM-DDTHH:MM`",
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional end of the date range the entries were last updated in, in the format `YYYY-MM-DDTHH:MM`",
+			},
+			"domain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional recipient domain to filter by",
+			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional subaccount ID to search the suppression list of. Defaults to the provider-level subaccount",
+			},
+			"suppressions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"recipient": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"source": schema.StringAttribute{
+							Computed: true,
+						},
+						"created": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *suppressionListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *suppressionListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	var config suppressionListDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	suppressionType := config.Type.ValueString()
+	if suppressionType != "" {
+		if err := ValidateSuppressionType(suppressionType); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Suppression Type", err.Error())
+			return
+		}
+	}
+
+	subaccount := int(config.Subaccount.ValueInt64())
+	if config.Subaccount.IsNull() {
+		subaccount = client.Subaccount
+	}
+
+	var suppressions []Suppression
+	if recipient := config.Recipient.ValueString(); recipient != "" {
+		found, err := client.GetSuppressions(recipient, subaccount)
+		if err != nil && err != SuppressionNotFound {
+			resp.Diagnostics.AddError("Failed to fetch suppressions", fmt.Sprintf("Error: %s", err))
+			return
+		}
+		for _, entry := range found {
+			if suppressionType == "" || entry.Type == suppressionType {
+				suppressions = append(suppressions, entry)
+			}
+		}
+	} else {
+		search := SuppressionSearch{
+			From:   config.From.ValueString(),
+			To:     config.To.ValueString(),
+			Domain: config.Domain.ValueString(),
+		}
+		if suppressionType != "" {
+			search.Types = []string{suppressionType}
+		}
+		resp.Diagnostics.Append(config.Sources.ElementsAs(ctx, &search.Sources, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		suppressions, err = client.SearchSuppressions(search, subaccount)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to fetch suppressions", fmt.Sprintf("Error: %s", err))
+			return
+		}
+	}
+
+	var objs []attr.Value
+	for _, entry := range suppressions {
+		obj, diag := types.ObjectValue(suppressionAttrTypes, map[string]attr.Value{
+			"recipient":   types.StringValue(entry.Recipient),
+			"type":        types.StringValue(entry.Type),
+			"description": types.StringValue(entry.Description),
+			"source":      types.StringValue(entry.Source),
+			"created":     types.StringValue(entry.Created),
+			"updated":     types.StringValue(entry.Updated),
+		})
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+		objs = append(objs, obj)
+	}
+
+	listVal, diag := types.ListValue(types.ObjectType{AttrTypes: suppressionAttrTypes}, objs)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+	config.Suppressions = listVal
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &usersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *SparkPostClient
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"access_level": schema.StringAttribute{
+							Computed: true,
+						},
+						"subaccount": schema.Int64Attribute{
+							Computed: true,
+						},
+						"tfa_enabled": schema.BoolAttribute{
+							Computed: true,
+						},
+						"tfa_required": schema.BoolAttribute{
+							Computed: true,
+						},
+						"last_login": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
+	users, err := client.ListUsers()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch users", fmt.Sprintf("Error: %s", err))
+		return
+	}
+
+	var objs []attr.Value
+	attrTypes := map[string]attr.Type{
+		"username":     types.StringType,
+		"email":        types.StringType,
+		"access_level": types.StringType,
+		"subaccount":   types.Int64Type,
+		"tfa_enabled":  types.BoolType,
+		"tfa_required": types.BoolType,
+		"last_login":   types.StringType,
+	}
+
+	for _, user := range users {
+		subaccount := types.Int64Null()
+		if user.SubaccountID > 0 {
+			subaccount = types.Int64Value(int64(user.SubaccountID))
+		}
+
+		obj, diag := types.ObjectValue(attrTypes, map[string]attr.Value{
+			"username":     types.StringValue(user.Username),
+			"email":        types.StringValue(user.Email),
+			"access_level": types.StringValue(user.AccessLevel),
+			"subaccount":   subaccount,
+			"tfa_enabled":  types.BoolValue(user.TFAEnabled),
+			"tfa_required": types.BoolValue(user.TFARequired),
+			"last_login":   types.StringValue(user.LastLogin),
+		})
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+		objs = append(objs, obj)
+	}
+
+	listVal, diag := types.ListValue(types.ObjectType{AttrTypes: attrTypes}, objs)
+	if diag.HasError() {
+		resp.Diagnostics.Append(diag...)
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, path.Root("users"), listVal)
+	resp.Diagnostics.Append(diags...)
+}
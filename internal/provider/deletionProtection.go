@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionDefault plans deletion_protection when it is not
+// configured: true for new resources, and the current value for existing
+// ones. Resources created before the attribute existed have no value and stay
+// unprotected, so upgrading the provider doesn't change them.
+type deletionProtectionDefault struct{}
+
+func (m deletionProtectionDefault) Description(ctx context.Context) string {
+	return "Defaults to true for new resources and keeps the current value of existing ones"
+}
+
+func (m deletionProtectionDefault) MarkdownDescription(ctx context.Context) string {
+	return "Defaults to `true` for new resources and keeps the current value of existing ones"
+}
+
+func (m deletionProtectionDefault) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	switch {
+	case req.State.Raw.IsNull():
+		resp.PlanValue = types.BoolValue(true)
+	case req.StateValue.IsNull():
+		resp.PlanValue = types.BoolValue(false)
+	default:
+		resp.PlanValue = req.StateValue
+	}
+}
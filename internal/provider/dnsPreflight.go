@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DNSLookup resolves the records checked before verification. *net.Resolver
+// implements it, other implementations can stand in for a DNS server
+type DNSLookup interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
+}
+
+// dnsLookupTimeout bounds each preflight lookup
+const dnsLookupTimeout = 10 * time.Second
+
+// NewDNSResolver returns a resolver that queries the DNS server at address,
+// given as "host" or "host:port", or the system resolver if address is empty
+func NewDNSResolver(address string) DNSLookup {
+	if address == "" {
+		return net.DefaultResolver
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "53")
+	}
+
+	dialer := &net.Dialer{Timeout: dnsLookupTimeout}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// DNSMismatch is a record that did not resolve to the expected value
+type DNSMismatch struct {
+	Record DNSRecord
+	Actual []string
+	Err    error
+}
+
+// DNSMismatchError lists the records that did not resolve as expected
+type DNSMismatchError struct {
+	Mismatches []DNSMismatch
+}
+
+func (e *DNSMismatchError) Error() string {
+	var b strings.Builder
+	for i, m := range e.Mismatches {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s %s (%s)\n", m.Record.Type, m.Record.Name, m.Record.Purpose)
+		fmt.Fprintf(&b, "  expected: %s\n", m.Record.Value)
+		switch {
+		case m.Err != nil:
+			fmt.Fprintf(&b, "  actual:   lookup failed: %s\n", m.Err)
+		case len(m.Actual) == 0:
+			b.WriteString("  actual:   no record\n")
+		default:
+			for j, actual := range m.Actual {
+				label := "  actual:   "
+				if j > 0 {
+					label = "            "
+				}
+				fmt.Fprintf(&b, "%s%s\n", label, actual)
+			}
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// CheckDNSRecords resolves records through lookup and returns a
+// *DNSMismatchError for those that don't have the expected value
+func CheckDNSRecords(ctx context.Context, lookup DNSLookup, records []DNSRecord) error {
+	var mismatches []DNSMismatch
+	for _, record := range records {
+		actual, err := resolveDNSRecord(ctx, lookup, record)
+		if err != nil || !dnsRecordMatches(record, actual) {
+			mismatches = append(mismatches, DNSMismatch{Record: record, Actual: actual, Err: err})
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return &DNSMismatchError{Mismatches: mismatches}
+	}
+	return nil
+}
+
+func resolveDNSRecord(ctx context.Context, lookup DNSLookup, record DNSRecord) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, dnsLookupTimeout)
+	defer cancel()
+
+	switch record.Type {
+	case "TXT":
+		values, err := lookup.LookupTXT(ctx, record.Name)
+		if isDNSNotFound(err) {
+			return nil, nil
+		}
+		return values, err
+	case "CNAME":
+		value, err := lookup.LookupCNAME(ctx, record.Name)
+		if isDNSNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Without a CNAME record the lookup returns the name itself
+		if normalizeDNSName(value) == normalizeDNSName(record.Name) {
+			return nil, nil
+		}
+		return []string{strings.TrimSuffix(value, ".")}, nil
+	}
+	return nil, fmt.Errorf("unsupported record type '%s'", record.Type)
+}
+
+func isDNSNotFound(err error) bool {
+	dnsErr, ok := err.(*net.DNSError)
+	return ok && dnsErr.IsNotFound
+}
+
+func dnsRecordMatches(record DNSRecord, actual []string) bool {
+	for _, value := range actual {
+		switch {
+		case record.Type == "CNAME":
+			if normalizeDNSName(value) == normalizeDNSName(record.Value) {
+				return true
+			}
+		case record.Purpose == "dkim":
+			// Only the public key matters, tags may be ordered differently
+			if dkimTag(value, "p") == dkimTag(record.Value, "p") {
+				return true
+			}
+		default:
+			if strings.Join(strings.Fields(value), "") == strings.Join(strings.Fields(record.Value), "") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func normalizeDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// dkimTag returns the value of tag in a DKIM TXT record, ignoring whitespace
+func dkimTag(record string, tag string) string {
+	for _, part := range strings.Split(record, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if ok && strings.TrimSpace(name) == tag {
+			return strings.Join(strings.Fields(value), "")
+		}
+	}
+	return ""
+}
+
+// dnsRecordsWithPurpose returns the records with the given purpose
+func dnsRecordsWithPurpose(records []DNSRecord, purpose string) []DNSRecord {
+	var matches []DNSRecord
+	for _, record := range records {
+		if record.Purpose == purpose {
+			matches = append(matches, record)
+		}
+	}
+	return matches
+}
+
+// verifyWithDNSPreflight checks records through the client's resolver before
+// calling verify. A failed verification is reported under summary together
+// with the DNS check, so it is clear whether DNS is wrong or SparkPost hasn't
+// seen the records yet
+func verifyWithDNSPreflight(ctx context.Context, client *SparkPostClient, summary string, records []DNSRecord, verify func() error, diags *diag.Diagnostics) {
+	var preflight error
+	if len(records) > 0 {
+		preflight = CheckDNSRecords(ctx, client.Resolver, records)
+	}
+
+	err := verify()
+	switch {
+	case err != nil && preflight != nil:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("%s\n\nThe DNS records don't resolve as expected yet:\n\n%s", err, preflight),
+		)
+	case err != nil && len(records) > 0:
+		diags.AddError(
+			summary,
+			fmt.Sprintf("%s\n\nThe DNS records resolve as expected, so SparkPost may not have seen them yet. Try again later.", err),
+		)
+	case err != nil:
+		diags.AddError(summary, err.Error())
+	case preflight != nil:
+		diags.AddWarning(
+			"DNS Preflight Mismatch",
+			fmt.Sprintf("SparkPost verified the domain, but the DNS records don't resolve as expected through the configured resolver:\n\n%s", preflight),
+		)
+	}
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// fakeDNS stands in for a DNS server, answering from its maps
+type fakeDNS struct {
+	txt   map[string][]string
+	cname map[string]string
+	err   error
+}
+
+func (f *fakeDNS) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	values, ok := f.txt[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return values, nil
+}
+
+func (f *fakeDNS) LookupCNAME(ctx context.Context, host string) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	value, ok := f.cname[host]
+	if !ok {
+		// Like net.Resolver, return the name itself when there is no CNAME
+		return host + ".", nil
+	}
+	return value, nil
+}
+
+var testRecords = []DNSRecord{
+	{Type: "TXT", Name: "scph0123._domainkey.example.com", Value: "v=DKIM1; k=rsa; h=sha256; p=KEY", Purpose: "dkim"},
+	{Type: "CNAME", Name: "example.com", Value: "sparkpostmail.com", Purpose: "bounce"},
+}
+
+func TestCheckDNSRecords(t *testing.T) {
+	tests := []struct {
+		name       string
+		dns        *fakeDNS
+		mismatches []string
+	}{
+		{
+			name: "all records match",
+			dns: &fakeDNS{
+				txt:   map[string][]string{"scph0123._domainkey.example.com": {"k=rsa; v=DKIM1; p=KEY"}},
+				cname: map[string]string{"example.com": "SparkPostMail.com."},
+			},
+		},
+		{
+			name: "missing records",
+			dns:  &fakeDNS{},
+			mismatches: []string{
+				"scph0123._domainkey.example.com",
+				"example.com",
+			},
+		},
+		{
+			name: "wrong values",
+			dns: &fakeDNS{
+				txt:   map[string][]string{"scph0123._domainkey.example.com": {"v=DKIM1; p=OTHER"}},
+				cname: map[string]string{"example.com": "eu.sparkpostmail.com."},
+			},
+			mismatches: []string{
+				"scph0123._domainkey.example.com",
+				"example.com",
+			},
+		},
+		{
+			name: "lookup failure",
+			dns:  &fakeDNS{err: errors.New("server misbehaving")},
+			mismatches: []string{
+				"scph0123._domainkey.example.com",
+				"example.com",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckDNSRecords(context.Background(), tt.dns, testRecords)
+			if len(tt.mismatches) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+				return
+			}
+
+			var mismatchErr *DNSMismatchError
+			if !errors.As(err, &mismatchErr) {
+				t.Fatalf("expected *DNSMismatchError, got: %v", err)
+			}
+			if len(mismatchErr.Mismatches) != len(tt.mismatches) {
+				t.Fatalf("expected %d mismatches, got: %d", len(tt.mismatches), len(mismatchErr.Mismatches))
+			}
+			for i, name := range tt.mismatches {
+				if mismatchErr.Mismatches[i].Record.Name != name {
+					t.Errorf("expected mismatch %d for %s, got: %s", i, name, mismatchErr.Mismatches[i].Record.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestDNSRecordMatches(t *testing.T) {
+	dkim := testRecords[0]
+	bounce := testRecords[1]
+	spf := DNSRecord{Type: "TXT", Name: "example.com", Value: "v=spf1 include:sparkpostmail.com ~all"}
+
+	tests := []struct {
+		name   string
+		record DNSRecord
+		actual []string
+		want   bool
+	}{
+		{"dkim with same key", dkim, []string{"v=DKIM1; k=rsa; h=sha256; p=KEY"}, true},
+		{"dkim with reordered tags", dkim, []string{"p=KEY; v=DKIM1"}, true},
+		{"dkim with split key", dkim, []string{"v=DKIM1; p=K EY"}, true},
+		{"dkim with other key", dkim, []string{"v=DKIM1; p=OTHER"}, false},
+		{"dkim among other records", dkim, []string{"v=spf1 -all", "v=DKIM1; p=KEY"}, true},
+		{"cname with trailing dot", bounce, []string{"sparkpostmail.com."}, true},
+		{"cname with other case", bounce, []string{"SPARKPOSTMAIL.COM"}, true},
+		{"cname with other target", bounce, []string{"eu.sparkpostmail.com"}, false},
+		{"txt ignoring whitespace", spf, []string{"v=spf1  include:sparkpostmail.com ~all"}, true},
+		{"txt with other value", spf, []string{"v=spf1 -all"}, false},
+		{"no records", bounce, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dnsRecordMatches(tt.record, tt.actual); got != tt.want {
+				t.Errorf("expected %t, got: %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestVerifyWithDNSPreflight(t *testing.T) {
+	matching := &fakeDNS{
+		txt:   map[string][]string{"scph0123._domainkey.example.com": {"v=DKIM1; k=rsa; h=sha256; p=KEY"}},
+		cname: map[string]string{"example.com": "sparkpostmail.com."},
+	}
+	verifyErr := errors.New("domain not verified")
+
+	tests := []struct {
+		name      string
+		dns       *fakeDNS
+		records   []DNSRecord
+		verify    error
+		errDetail string
+		warning   bool
+	}{
+		{
+			name:    "verified and resolving",
+			dns:     matching,
+			records: testRecords,
+		},
+		{
+			name:    "verified but not resolving",
+			dns:     &fakeDNS{},
+			records: testRecords,
+			warning: true,
+		},
+		{
+			name:      "not verified and not resolving",
+			dns:       &fakeDNS{},
+			records:   testRecords,
+			verify:    verifyErr,
+			errDetail: "don't resolve as expected yet",
+		},
+		{
+			name:      "not verified but resolving",
+			dns:       matching,
+			records:   testRecords,
+			verify:    verifyErr,
+			errDetail: "resolve as expected, so SparkPost may not have seen them yet",
+		},
+		{
+			name:      "not verified without records",
+			dns:       &fakeDNS{},
+			verify:    verifyErr,
+			errDetail: "domain not verified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &SparkPostClient{Resolver: tt.dns}
+			var diags diag.Diagnostics
+			verifyWithDNSPreflight(context.Background(), client, "Create Error", tt.records, func() error {
+				return tt.verify
+			}, &diags)
+
+			errs := diags.Errors()
+			if tt.errDetail == "" && len(errs) > 0 {
+				t.Fatalf("expected no error, got: %s", errs[0].Detail())
+			}
+			if tt.errDetail != "" {
+				if len(errs) != 1 {
+					t.Fatalf("expected 1 error, got: %d", len(errs))
+				}
+				if errs[0].Summary() != "Create Error" {
+					t.Errorf("expected summary 'Create Error', got: %s", errs[0].Summary())
+				}
+				if !strings.Contains(errs[0].Detail(), tt.errDetail) {
+					t.Errorf("expected detail to contain %q, got: %s", tt.errDetail, errs[0].Detail())
+				}
+				if len(tt.records) == 0 && strings.Contains(errs[0].Detail(), "resolve as expected") {
+					t.Errorf("expected no DNS claim without records, got: %s", errs[0].Detail())
+				}
+			}
+
+			if warned := len(diags.Warnings()) > 0; warned != tt.warning {
+				t.Errorf("expected warning %t, got: %t", tt.warning, warned)
+			}
+		})
+	}
+}
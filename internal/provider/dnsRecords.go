@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DNSRecord is a DNS record SparkPost expects to exist for a domain
+type DNSRecord struct {
+	Type    string
+	Name    string
+	Value   string
+	Purpose string
+}
+
+// BounceCNAMETarget returns the host bounce domains point their CNAME record
+// at in the given region, either "us" or "eu"
+func BounceCNAMETarget(region string) string {
+	if region == "eu" {
+		return "eu.sparkpostmail.com"
+	}
+	return "sparkpostmail.com"
+}
+
+// TrackingCNAMETarget returns the host tracking domains point their CNAME
+// record at in the given region, either "us" or "eu"
+func TrackingCNAMETarget(region string) string {
+	if region == "eu" {
+		return "eu.spgo.io"
+	}
+	return "spgo.io"
+}
+
+// DKIMRecordName returns the name of the DKIM TXT record of domain
+func DKIMRecordName(selector string, domain string) string {
+	return fmt.Sprintf("%s._domainkey.%s", selector, domain)
+}
+
+// DKIMTXTValue returns the value of the DKIM TXT record for a public key
+func DKIMTXTValue(publicKey string) string {
+	return fmt.Sprintf("v=DKIM1; k=rsa; h=sha256; p=%s", publicKey)
+}
+
+// SendingDomainDNSRecords returns the DKIM and bounce records of a sending
+// domain in the given region
+func SendingDomainDNSRecords(domain TargetDomain, region string) []DNSRecord {
+	var records []DNSRecord
+
+	if domain.DKIM != nil && domain.DKIM.Selector != "" && domain.DKIM.Public != "" {
+		signingDomain := domain.DKIM.SigningDomain
+		if signingDomain == "" {
+			signingDomain = domain.Domain
+		}
+		records = append(records, DNSRecord{
+			Type:    "TXT",
+			Name:    DKIMRecordName(domain.DKIM.Selector, signingDomain),
+			Value:   DKIMTXTValue(domain.DKIM.Public),
+			Purpose: "dkim",
+		})
+	}
+
+	records = append(records, DNSRecord{
+		Type:    "CNAME",
+		Name:    domain.Domain,
+		Value:   BounceCNAMETarget(region),
+		Purpose: "bounce",
+	})
+
+	return records
+}
+
+// TrackingDomainDNSRecords returns the CNAME record of a tracking domain in
+// the given region
+func TrackingDomainDNSRecords(domain string, region string) []DNSRecord {
+	return []DNSRecord{
+		{
+			Type:    "CNAME",
+			Name:    domain,
+			Value:   TrackingCNAMETarget(region),
+			Purpose: "tracking",
+		},
+	}
+}
+
+var dnsRecordAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"name":    types.StringType,
+	"value":   types.StringType,
+	"purpose": types.StringType,
+}
+
+// dnsRecordsAttribute is the schema of the computed dns_records attribute
+func dnsRecordsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The DNS records to create for the domain, based on the provider region",
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The record type, either `TXT` or `CNAME`",
+				},
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The fully qualified record name",
+				},
+				"value": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The record value",
+				},
+				"purpose": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "What the record is for, either `dkim`, `bounce` or `tracking`",
+				},
+			},
+		},
+	}
+}
+
+// dnsRecordsValue converts records into the value of a dns_records attribute
+func dnsRecordsValue(records []DNSRecord) (types.List, diag.Diagnostics) {
+	objectType := types.ObjectType{AttrTypes: dnsRecordAttrTypes}
+
+	var diags diag.Diagnostics
+	values := make([]attr.Value, 0, len(records))
+	for _, record := range records {
+		value, d := types.ObjectValue(dnsRecordAttrTypes, map[string]attr.Value{
+			"type":    types.StringValue(record.Type),
+			"name":    types.StringValue(record.Name),
+			"value":   types.StringValue(record.Value),
+			"purpose": types.StringValue(record.Purpose),
+		})
+		diags.Append(d...)
+		values = append(values, value)
+	}
+	if diags.HasError() {
+		return types.ListNull(objectType), diags
+	}
+
+	list, d := types.ListValue(objectType, values)
+	diags.Append(d...)
+	return list, diags
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// domainValidators returns the validators for attributes holding a domain that
+// is managed in SparkPost, such as a sending, tracking or inbound domain.
+func domainValidators() []validator.String {
+	return []validator.String{
+		domainNameValidator{},
+		mailboxProviderDomainValidator{},
+	}
+}
+
+var domainLabelRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// domainRequiresReplace replaces the resource when its domain changes, but
+// not when only the case or a trailing dot changes
+func domainRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = NormalizeDomainName(req.PlanValue.ValueString()) != NormalizeDomainName(req.StateValue.ValueString())
+		},
+		"Changing the domain other than its case or trailing dot requires replacement",
+		"Changing the domain other than its case or trailing dot requires replacement",
+	)
+}
+
+// NormalizeDomainName returns domain in lowercase without trailing dot, which
+// is how SparkPost stores domains
+func NormalizeDomainName(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(domain), ".")
+}
+
+// ValidateDomainName checks that domain is an RFC 1123 hostname with at least
+// two labels, without scheme, path or port. Uppercase letters and a trailing
+// dot are allowed, as the domain is normalized with NormalizeDomainName.
+func ValidateDomainName(domain string) error {
+	if domain == "" {
+		return fmt.Errorf("domain is empty")
+	}
+	if i := strings.Index(domain, "://"); i >= 0 {
+		return fmt.Errorf("'%s' must not contain a scheme. Use '%s' instead", domain, strings.SplitN(domain[i+3:], "/", 2)[0])
+	}
+	if strings.ContainsAny(domain, "/:?#@ ") {
+		return fmt.Errorf("'%s' must be a bare domain name without path, port or credentials", domain)
+	}
+	normalized := NormalizeDomainName(domain)
+	if len(normalized) > 253 {
+		return fmt.Errorf("'%s' is longer than 253 characters", domain)
+	}
+
+	labels := strings.Split(normalized, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("'%s' must be a fully qualified domain name such as 'mail.example.com'", domain)
+	}
+	for _, label := range labels {
+		if !domainLabelRegexp.MatchString(label) {
+			return fmt.Errorf("'%s' is not a valid hostname: label '%s' must be 1 to 63 letters, digits or hyphens and must not start or end with a hyphen", domain, label)
+		}
+	}
+
+	return nil
+}
+
+// mailboxProviderDomains are domains of public mailbox providers, which can't
+// be verified for sending, tracking or inbound relaying
+var mailboxProviderDomains = []string{
+	"163.com",
+	"aol.com",
+	"gmail.com",
+	"gmx.com",
+	"gmx.de",
+	"googlemail.com",
+	"hotmail.com",
+	"icloud.com",
+	"live.com",
+	"mac.com",
+	"mail.ru",
+	"me.com",
+	"msn.com",
+	"outlook.com",
+	"proton.me",
+	"protonmail.com",
+	"qq.com",
+	"web.de",
+	"yahoo.com",
+	"yandex.ru",
+	"zoho.com",
+}
+
+// ValidateNotMailboxProviderDomain checks that domain is not, and is not a
+// subdomain of, a public mailbox provider domain.
+func ValidateNotMailboxProviderDomain(domain string) error {
+	domain = strings.ToLower(domain)
+	for _, mailboxDomain := range mailboxProviderDomains {
+		if domain == mailboxDomain || strings.HasSuffix(domain, "."+mailboxDomain) {
+			return fmt.Errorf("'%s' belongs to the public mailbox provider '%s' and can't be used with SparkPost. Use a domain you own instead", domain, mailboxDomain)
+		}
+	}
+	return nil
+}
+
+// domainNameValidator validates attributes with ValidateDomainName
+type domainNameValidator struct{}
+
+func (v domainNameValidator) Description(ctx context.Context) string {
+	return "value must be a domain name without scheme, path or port"
+}
+
+func (v domainNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateDomainName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Domain", err.Error())
+	}
+}
+
+// mailboxProviderDomainValidator validates attributes with
+// ValidateNotMailboxProviderDomain
+type mailboxProviderDomainValidator struct{}
+
+func (v mailboxProviderDomainValidator) Description(ctx context.Context) string {
+	return "value must not be a public mailbox provider domain"
+}
+
+func (v mailboxProviderDomainValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mailboxProviderDomainValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateNotMailboxProviderDomain(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Domain", err.Error())
+	}
+}
+
+// differentDomainsValidator rejects configurations where two domain
+// attributes hold the same domain.
+type differentDomainsValidator struct {
+	first  string
+	second string
+}
+
+func (v differentDomainsValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("'%s' must differ from '%s'", v.second, v.first)
+}
+
+func (v differentDomainsValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("`%s` must differ from `%s`", v.second, v.first)
+}
+
+func (v differentDomainsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var first, second types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.first), &first)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.second), &second)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if first.IsNull() || first.IsUnknown() || second.IsNull() || second.IsUnknown() {
+		return
+	}
+
+	if NormalizeDomainName(first.ValueString()) == NormalizeDomainName(second.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(v.second),
+			"Invalid Configuration",
+			fmt.Sprintf("'%s' must differ from '%s', both are '%s'.", v.second, v.first, first.ValueString()),
+		)
+	}
+}
@@ -6,8 +6,20 @@ import (
 	"strconv"
 )
 
+type DomainDKIM struct {
+	Public        string `json:"public"`
+	Selector      string `json:"selector"`
+	Headers       string `json:"headers,omitempty"`
+	SigningDomain string `json:"signing_domain,omitempty"`
+}
+
 type TargetDomain struct {
-	Domain string `json:"domain"`
+	Domain                string      `json:"domain"`
+	TrackingDomain        string      `json:"tracking_domain,omitempty"`
+	SharedWithSubaccounts bool        `json:"shared_with_subaccounts"`
+	IsDefaultBounceDomain bool        `json:"is_default_bounce_domain"`
+	SubaccountID          int         `json:"subaccount_id,omitempty"`
+	DKIM                  *DomainDKIM `json:"dkim,omitempty"`
 }
 
 func (c *SparkPostClient) CreateDomain(domain string, subaccount int, shared bool, defaultBounce bool) error {
@@ -49,19 +61,85 @@ func (c *SparkPostClient) GetDomain(domain string, subaccount int) (*TargetDomai
 
 	resp, err := c.doRequest(req, 200)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, DomainNotFound
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var targetDomain TargetDomain
-	err = json.NewDecoder(resp.Body).Decode(&targetDomain)
+	var respBody struct {
+		Results TargetDomain `json:"results"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&respBody)
 	if err != nil {
 		return nil, err
 	}
 
+	// The domain is only part of the endpoint, not of the response
+	targetDomain := respBody.Results
+	targetDomain.Domain = domain
+
 	return &targetDomain, nil
 }
 
+// ListDomains returns the sending domains of the account, including those of
+// its subaccounts, or only those of the given subaccount
+func (c *SparkPostClient) ListDomains(subaccount int) ([]TargetDomain, error) {
+	req, err := c.newRequest("GET", "sending-domains", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return nil, fmt.Errorf("list sending domains request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results []TargetDomain `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse list sending domains response: %w", err)
+	}
+
+	return respBody.Results, nil
+}
+
+func (c *SparkPostClient) UpdateDomain(domain string, subaccount int, shared bool, defaultBounce bool) error {
+	endpoint := fmt.Sprintf("sending-domains/%s", domain)
+
+	body := map[string]interface{}{
+		"shared_with_subaccounts":  shared,
+		"is_default_bounce_domain": defaultBounce,
+	}
+
+	req, err := c.newRequest("PUT", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return DomainNotFound
+		}
+		return fmt.Errorf("update sending domain request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (c *SparkPostClient) DeleteDomain(domain string, subaccount int) error {
 	endpoint := fmt.Sprintf("sending-domains/%s", domain)
 
@@ -121,7 +199,7 @@ func (c *SparkPostClient) VerifyDomainOwnership(domain string, subaccount int) e
 	}
 
 	if respBody.Results.OwnershipVerified != true {
-		return fmt.Errorf("verification failed: ownership_verified = '%s'", respBody.Results.OwnershipVerified)
+		return fmt.Errorf("verification failed: ownership_verified = '%t'", respBody.Results.OwnershipVerified)
 	}
 
 	return nil
@@ -184,6 +262,9 @@ func (c *SparkPostClient) AssociateTrackingDomain(domain string, subaccount int,
 
 	resp, err := c.doRequest(req, 200)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return DomainNotFound
+		}
 		return fmt.Errorf("association request failed: %w", err)
 	}
 	defer resp.Body.Close()
@@ -219,6 +300,9 @@ func (c *SparkPostClient) GetTrackingDomainAssociation(domain string, subaccount
 
 	resp, err := c.doRequest(req, 200)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return "", DomainNotFound
+		}
 		return "", fmt.Errorf("get tracking association request failed: %w", err)
 	}
 	defer resp.Body.Close()
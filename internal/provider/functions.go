@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &dkimRecordNameFunction{}
+	_ function.Function = &dkimTXTValueFunction{}
+	_ function.Function = &bounceCNAMETargetFunction{}
+	_ function.Function = &trackingCNAMETargetFunction{}
+)
+
+// ValidateRegion checks that region is a SparkPost region, either "us" or "eu"
+func ValidateRegion(region string) error {
+	switch region {
+	case "us", "eu":
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid region. Must be 'us' or 'eu'", region)
+}
+
+func NewDKIMRecordNameFunction() function.Function {
+	return &dkimRecordNameFunction{}
+}
+
+type dkimRecordNameFunction struct{}
+
+func (f *dkimRecordNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dkim_record_name"
+}
+
+func (f *dkimRecordNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the name of the DKIM TXT record of a sending domain",
+		MarkdownDescription: "Returns the name of the DKIM TXT record of a sending domain, `<selector>._domainkey.<domain>`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "selector",
+				MarkdownDescription: "The DKIM selector of the sending domain",
+			},
+			function.StringParameter{
+				Name:                "domain",
+				MarkdownDescription: "The sending domain, or its DKIM signing domain if different",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *dkimRecordNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var selector, domain string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &selector, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	if selector == "" {
+		resp.Error = function.NewArgumentFuncError(0, "selector must not be empty")
+		return
+	}
+	if err := ValidateDomainName(domain); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, DKIMRecordName(selector, NormalizeDomainName(domain))))
+}
+
+func NewDKIMTXTValueFunction() function.Function {
+	return &dkimTXTValueFunction{}
+}
+
+type dkimTXTValueFunction struct{}
+
+func (f *dkimTXTValueFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dkim_txt_value"
+}
+
+func (f *dkimTXTValueFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the value of the DKIM TXT record for a public key",
+		MarkdownDescription: "Returns the value of the DKIM TXT record for a public key, `v=DKIM1; k=rsa; h=sha256; p=<public_key>`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "public_key",
+				MarkdownDescription: "The base64 encoded DKIM public key of the sending domain",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *dkimTXTValueFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	if publicKey == "" {
+		resp.Error = function.NewArgumentFuncError(0, "public_key must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, DKIMTXTValue(publicKey)))
+}
+
+func NewBounceCNAMETargetFunction() function.Function {
+	return &bounceCNAMETargetFunction{}
+}
+
+type bounceCNAMETargetFunction struct{}
+
+func (f *bounceCNAMETargetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bounce_cname_target"
+}
+
+func (f *bounceCNAMETargetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the CNAME target of bounce domains in a region",
+		MarkdownDescription: "Returns the host bounce domains point their CNAME record at in a SparkPost region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "The SparkPost region, either `us` or `eu`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *bounceCNAMETargetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := ValidateRegion(region); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, BounceCNAMETarget(region)))
+}
+
+func NewTrackingCNAMETargetFunction() function.Function {
+	return &trackingCNAMETargetFunction{}
+}
+
+type trackingCNAMETargetFunction struct{}
+
+func (f *trackingCNAMETargetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tracking_cname_target"
+}
+
+func (f *trackingCNAMETargetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the CNAME target of tracking domains in a region",
+		MarkdownDescription: "Returns the host tracking domains point their CNAME record at in a SparkPost region. " +
+			"HTTPS tracking domains are served through your own CDN instead, which must use the returned host as its origin. " +
+			"There is no separate target for HTTPS, so the function takes no `secure` argument",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "The SparkPost region, either `us` or `eu`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *trackingCNAMETargetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := ValidateRegion(region); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, TrackingCNAMETarget(region)))
+}
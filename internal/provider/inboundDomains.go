@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type InboundDomain struct {
+	Domain string `json:"domain"`
+}
+
+func (c *SparkPostClient) CreateInboundDomain(domain string, subaccount int) error {
+	body := map[string]interface{}{
+		"domain": domain,
+	}
+
+	req, err := c.newRequest("POST", "inbound-domains", body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("create inbound domain request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) GetInboundDomain(domain string, subaccount int) (*InboundDomain, error) {
+	endpoint := fmt.Sprintf("inbound-domains/%s", domain)
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, InboundDomainNotFound
+		}
+		return nil, fmt.Errorf("get inbound domain request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results InboundDomain `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get inbound domain response: %w", err)
+	}
+
+	return &respBody.Results, nil
+}
+
+func (c *SparkPostClient) DeleteInboundDomain(domain string, subaccount int) error {
+	endpoint := fmt.Sprintf("inbound-domains/%s", domain)
+
+	req, err := c.newRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return InboundDomainNotFound
+		}
+		return fmt.Errorf("delete inbound domain request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+var InboundDomainNotFound = fmt.Errorf("inbound domain not found")
@@ -4,6 +4,8 @@ import (
 	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,6 +15,8 @@ import (
 
 // Ensure implementation satisfies the framework interfaces
 var _ provider.Provider = &sparkpostProvider{}
+var _ provider.ProviderWithValidateConfig = &sparkpostProvider{}
+var _ provider.ProviderWithFunctions = &sparkpostProvider{}
 
 func New() provider.Provider {
 	return &sparkpostProvider{}
@@ -24,8 +28,10 @@ type sparkpostProvider struct {
 
 // Provider-level config model
 type providerModel struct {
-	APIUrl types.String `tfsdk:"api_url"`
-	APIKey types.String `tfsdk:"api_key"`
+	APIUrl     types.String `tfsdk:"api_url"`
+	APIKey     types.String `tfsdk:"api_key"`
+	Subaccount  types.Int64  `tfsdk:"subaccount"`
+	DNSResolver types.String `tfsdk:"dns_resolver"`
 }
 
 func (p *sparkpostProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,10 +52,37 @@ func (p *sparkpostProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Description:         "API Key for SparkPost",
 				MarkdownDescription: "API Key for SparkPost",
 			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Default subaccount ID for subaccount-aware resources and data sources",
+				MarkdownDescription: "Default subaccount ID for subaccount-aware resources and data sources that set neither `subaccount` nor `subaccount_name`. Only applies to resources when they are created, so changing it never replaces existing resources",
+			},
+			"dns_resolver": schema.StringAttribute{
+				Optional:            true,
+				Description:         "DNS server used to check records before verifying domains",
+				MarkdownDescription: "DNS server used to check the expected records before verifying domains, as `host` or `host:port`. Defaults to the system resolver",
+			},
 		},
 	}
 }
 
+func (p *sparkpostProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config providerModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Subaccount.IsNull() && !config.Subaccount.IsUnknown() && config.Subaccount.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subaccount"),
+			"Invalid Configuration",
+			"'subaccount' must be a valid subaccount ID. Leave it unset to use the primary account.",
+		)
+	}
+}
+
 func (p *sparkpostProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config providerModel
 
@@ -66,6 +99,8 @@ func (p *sparkpostProvider) Configure(ctx context.Context, req provider.Configur
     }
 
 	client := NewSparkPostClient(apiUrl, config.APIKey.ValueString())
+	client.Subaccount = int(config.Subaccount.ValueInt64())
+	client.Resolver = NewDNSResolver(config.DNSResolver.ValueString())
 
 	p.client = client
 	resp.DataSourceData = client
@@ -80,11 +115,35 @@ func (p *sparkpostProvider) Resources(ctx context.Context) []func() resource.Res
 		NewBounceVerificationResource,
 		NewTrackingDomainVerificationResource,
 		NewTrackingDomainAssociationResource,
+		NewRecipientListResource,
+		NewSnippetResource,
+		NewSuppressionResource,
+		NewSuppressionListResource,
+		NewInboundDomainResource,
+		NewRelayWebhookResource,
+		NewAlertResource,
+		NewAccountOptionsResource,
+		NewUserResource,
+		NewSSOResource,
+		NewSubaccountSettingsResource,
 	}
 }
 
 func (p *sparkpostProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 	    NewSubAccountsDataSource,
+		NewSubaccountDataSource,
+		NewSuppressionListDataSource,
+		NewAccountDataSource,
+		NewUsersDataSource,
 	}
-}
\ No newline at end of file
+}
+
+func (p *sparkpostProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDKIMRecordNameFunction,
+		NewDKIMTXTValueFunction,
+		NewBounceCNAMETargetFunction,
+		NewTrackingCNAMETargetFunction,
+	}
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type RecipientAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type Recipient struct {
+	Address          RecipientAddress       `json:"address"`
+	Tags             []string               `json:"tags,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	SubstitutionData map[string]interface{} `json:"substitution_data,omitempty"`
+}
+
+type RecipientList struct {
+	ID          string      `json:"id,omitempty"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Recipients  []Recipient `json:"recipients"`
+}
+
+func (c *SparkPostClient) CreateRecipientList(list RecipientList, subaccount int) (string, error) {
+	req, err := c.newRequest("POST", "recipient-lists", list)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return "", fmt.Errorf("create recipient list request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results struct {
+			ID string `json:"id"`
+		} `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return "", fmt.Errorf("failed to parse create recipient list response: %w", err)
+	}
+
+	return respBody.Results.ID, nil
+}
+
+func (c *SparkPostClient) GetRecipientList(id string, subaccount int) (*RecipientList, error) {
+	endpoint := fmt.Sprintf("recipient-lists/%s?show_recipients=true", id)
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, RecipientListNotFound
+		}
+		return nil, fmt.Errorf("get recipient list request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results RecipientList `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get recipient list response: %w", err)
+	}
+
+	return &respBody.Results, nil
+}
+
+func (c *SparkPostClient) UpdateRecipientList(list RecipientList, subaccount int) error {
+	endpoint := fmt.Sprintf("recipient-lists/%s", list.ID)
+
+	// The ID is taken from the endpoint and must not be sent in the body
+	body := list
+	body.ID = ""
+
+	req, err := c.newRequest("PUT", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update recipient list request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) DeleteRecipientList(id string, subaccount int) error {
+	endpoint := fmt.Sprintf("recipient-lists/%s", id)
+
+	req, err := c.newRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return RecipientListNotFound
+		}
+		return fmt.Errorf("delete recipient list request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ParseRecipientsCSV parses recipients in the SparkPost CSV upload format.
+// The header row must contain an "email" column and may contain "name",
+// "tags", "metadata" and "substitution_data", where the last three are JSON.
+func ParseRecipientsCSV(data string) ([]Recipient, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("recipients CSV is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recipients CSV header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "email", "name", "tags", "metadata", "substitution_data":
+		default:
+			return nil, fmt.Errorf("unsupported recipients CSV column '%s'", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicate recipients CSV column '%s'", name)
+		}
+		columns[name] = i
+	}
+
+	if _, ok := columns["email"]; !ok {
+		return nil, fmt.Errorf("recipients CSV header must contain an 'email' column")
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var recipients []Recipient
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read recipients CSV: %w", err)
+		}
+
+		recipient := Recipient{
+			Address: RecipientAddress{
+				Email: field(record, "email"),
+				Name:  field(record, "name"),
+			},
+		}
+
+		if err := ValidateRecipientEmail(recipient.Address.Email); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if tags := field(record, "tags"); tags != "" {
+			if err := json.Unmarshal([]byte(tags), &recipient.Tags); err != nil {
+				return nil, fmt.Errorf("line %d: 'tags' must be a JSON array of strings: %w", line, err)
+			}
+		}
+
+		if metadata := field(record, "metadata"); metadata != "" {
+			if err := json.Unmarshal([]byte(metadata), &recipient.Metadata); err != nil {
+				return nil, fmt.Errorf("line %d: 'metadata' must be a JSON object: %w", line, err)
+			}
+		}
+
+		if substitutionData := field(record, "substitution_data"); substitutionData != "" {
+			if err := json.Unmarshal([]byte(substitutionData), &recipient.SubstitutionData); err != nil {
+				return nil, fmt.Errorf("line %d: 'substitution_data' must be a JSON object: %w", line, err)
+			}
+		}
+
+		recipients = append(recipients, recipient)
+	}
+
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("recipients CSV does not contain any recipients")
+	}
+
+	return recipients, nil
+}
+
+// FormatRecipientsCSV writes recipients in the format read by
+// ParseRecipientsCSV, with every column included
+func FormatRecipientsCSV(recipients []Recipient) (string, error) {
+	var b strings.Builder
+	writer := csv.NewWriter(&b)
+
+	if err := writer.Write([]string{"email", "name", "tags", "metadata", "substitution_data"}); err != nil {
+		return "", err
+	}
+
+	jsonField := func(value interface{}, empty bool) (string, error) {
+		if empty {
+			return "", nil
+		}
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	}
+
+	for _, recipient := range recipients {
+		tags, err := jsonField(recipient.Tags, len(recipient.Tags) == 0)
+		if err != nil {
+			return "", err
+		}
+		metadata, err := jsonField(recipient.Metadata, len(recipient.Metadata) == 0)
+		if err != nil {
+			return "", err
+		}
+		substitutionData, err := jsonField(recipient.SubstitutionData, len(recipient.SubstitutionData) == 0)
+		if err != nil {
+			return "", err
+		}
+
+		record := []string{recipient.Address.Email, recipient.Address.Name, tags, metadata, substitutionData}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	return b.String(), writer.Error()
+}
+
+// RecipientsEqual reports whether both slices hold the same recipients,
+// ignoring their order
+func RecipientsEqual(a, b []Recipient) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	encode := func(recipients []Recipient) []string {
+		encoded := make([]string, 0, len(recipients))
+		for _, recipient := range recipients {
+			// Maps are encoded with sorted keys, so equal recipients encode
+			// the same way
+			value, _ := json.Marshal(recipient)
+			encoded = append(encoded, string(value))
+		}
+		slices.Sort(encoded)
+		return encoded
+	}
+
+	return slices.Equal(encode(a), encode(b))
+}
+
+// ValidateRecipientEmail checks that the value is a bare email address
+func ValidateRecipientEmail(email string) error {
+	if email == "" {
+		return fmt.Errorf("recipient email address is empty")
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("'%s' is not a valid email address", email)
+	}
+
+	return nil
+}
+
+var RecipientListNotFound = fmt.Errorf("recipient list not found")
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type RelayWebhookMatch struct {
+	Protocol string `json:"protocol"`
+	Domain   string `json:"domain"`
+}
+
+type RelayWebhookAuthRequestDetails struct {
+	URL  string `json:"url"`
+	Body struct {
+		ClientID     string `json:"client_id"`
+		ClientSecret string `json:"client_secret,omitempty"`
+	} `json:"body"`
+}
+
+type RelayWebhook struct {
+	ID                 string                          `json:"id,omitempty"`
+	Name               string                          `json:"name"`
+	Target             string                          `json:"target"`
+	AuthToken          string                          `json:"auth_token,omitempty"`
+	AuthType           string                          `json:"auth_type,omitempty"`
+	AuthRequestDetails *RelayWebhookAuthRequestDetails `json:"auth_request_details,omitempty"`
+	Match              RelayWebhookMatch               `json:"match"`
+}
+
+func (c *SparkPostClient) CreateRelayWebhook(webhook RelayWebhook, subaccount int) (string, error) {
+	req, err := c.newRequest("POST", "relay-webhooks", webhook)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return "", fmt.Errorf("create relay webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results struct {
+			ID string `json:"id"`
+		} `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return "", fmt.Errorf("failed to parse create relay webhook response: %w", err)
+	}
+
+	return respBody.Results.ID, nil
+}
+
+func (c *SparkPostClient) GetRelayWebhook(id string, subaccount int) (*RelayWebhook, error) {
+	endpoint := fmt.Sprintf("relay-webhooks/%s", id)
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, RelayWebhookNotFound
+		}
+		return nil, fmt.Errorf("get relay webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results RelayWebhook `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get relay webhook response: %w", err)
+	}
+
+	return &respBody.Results, nil
+}
+
+func (c *SparkPostClient) UpdateRelayWebhook(webhook RelayWebhook, subaccount int) error {
+	endpoint := fmt.Sprintf("relay-webhooks/%s", webhook.ID)
+
+	// The ID is taken from the endpoint and must not be sent in the body
+	body := webhook
+	body.ID = ""
+
+	req, err := c.newRequest("PUT", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update relay webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) DeleteRelayWebhook(id string, subaccount int) error {
+	endpoint := fmt.Sprintf("relay-webhooks/%s", id)
+
+	req, err := c.newRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return RelayWebhookNotFound
+		}
+		return fmt.Errorf("delete relay webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+var RelayWebhookNotFound = fmt.Errorf("relay webhook not found")
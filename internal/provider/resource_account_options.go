@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accountOptionsResource struct {
+	client *SparkPostClient
+}
+
+func NewAccountOptionsResource() resource.Resource {
+	return &accountOptionsResource{}
+}
+
+type accountOptionsResourceModel struct {
+	ClickTracking        types.Bool     `tfsdk:"click_tracking"`
+	OpenTracking         types.Bool     `tfsdk:"open_tracking"`
+	RestTrackingDefault  types.Bool     `tfsdk:"rest_tracking_default"`
+	SMTPTrackingDefault  types.Bool     `tfsdk:"smtp_tracking_default"`
+	TransactionalUnsub   types.Bool     `tfsdk:"transactional_unsub"`
+	TransactionalDefault types.Bool     `tfsdk:"transactional_default"`
+	Id                   types.String   `tfsdk:"id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *accountOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_options"
+}
+
+func (r *accountOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	option := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: description + ". Left unchanged if not set",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the account-wide options. Only one instance should exist per account. Destroying it restores the SparkPost defaults of the options set in the configuration and leaves the others unchanged",
+		Attributes: map[string]schema.Attribute{
+			"click_tracking":        option("Optional to enable click tracking for the account"),
+			"open_tracking":         option("Optional to enable open tracking for the account"),
+			"rest_tracking_default": option("Optional to track opens and clicks by default for messages sent through the REST API"),
+			"smtp_tracking_default": option("Optional to track opens and clicks by default for messages sent through SMTP"),
+			"transactional_unsub":   option("Optional to include the unsubscribe link header in transactional messages"),
+			"transactional_default": option("Optional to treat messages as transactional by default"),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The customer ID of the account used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *accountOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *accountOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accountOptionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.UpdateAccountOptions(accountOptionsFromModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	account, err := client.GetAccount(false)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, accountOptionsToModel(account, plan.Timeouts))
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(setConfiguredAccountOptions(ctx, req.Config, resp.Private)...)
+}
+
+func (r *accountOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accountOptionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := client.GetAccount(false)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, accountOptionsToModel(account, state.Timeouts))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *accountOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan accountOptionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.UpdateAccountOptions(accountOptionsFromModel(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	account, err := client.GetAccount(false)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, accountOptionsToModel(account, plan.Timeouts))
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(setConfiguredAccountOptions(ctx, req.Config, resp.Private)...)
+}
+
+func (r *accountOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state accountOptionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the options set in the configuration are restored
+	data, diags := req.Private.GetKey(ctx, accountOptionsPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	if data != nil {
+		if err := json.Unmarshal(data, &names); err != nil {
+			resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("failed to parse the configured options: %s", err))
+			return
+		}
+	}
+
+	if len(names) > 0 {
+		err := client.UpdateAccountOptions(defaultAccountOptionsFor(names))
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Error", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// accountOptionsPrivateKey is the private state key holding the names of the
+// options set in the configuration
+const accountOptionsPrivateKey = "configured_options"
+
+// privateState is implemented by the private state of create and update
+// responses
+type privateState interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setConfiguredAccountOptions records the options set in the configuration,
+// so destroying the resource only restores those
+func setConfiguredAccountOptions(ctx context.Context, config tfsdk.Config, private privateState) diag.Diagnostics {
+	var model accountOptionsResourceModel
+	diags := config.Get(ctx, &model)
+	if diags.HasError() {
+		return diags
+	}
+
+	options := map[string]types.Bool{
+		"click_tracking":        model.ClickTracking,
+		"open_tracking":         model.OpenTracking,
+		"rest_tracking_default": model.RestTrackingDefault,
+		"smtp_tracking_default": model.SMTPTrackingDefault,
+		"transactional_unsub":   model.TransactionalUnsub,
+		"transactional_default": model.TransactionalDefault,
+	}
+
+	names := []string{}
+	for name, value := range options {
+		if !value.IsNull() {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	data, err := json.Marshal(names)
+	if err != nil {
+		diags.AddError("Conversion Error", fmt.Sprintf("failed to encode the configured options: %s", err))
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, accountOptionsPrivateKey, data)...)
+	return diags
+}
+
+// defaultAccountOptionsFor returns the SparkPost defaults of the named options
+// only, leaving the others unchanged
+func defaultAccountOptionsFor(names []string) AccountOptions {
+	defaults := DefaultAccountOptions()
+
+	var options AccountOptions
+	for _, name := range names {
+		switch name {
+		case "click_tracking":
+			options.ClickTracking = defaults.ClickTracking
+		case "open_tracking":
+			options.OpenTracking = defaults.OpenTracking
+		case "rest_tracking_default":
+			options.RestTrackingDefault = defaults.RestTrackingDefault
+		case "smtp_tracking_default":
+			options.SMTPTrackingDefault = defaults.SMTPTrackingDefault
+		case "transactional_unsub":
+			options.TransactionalUnsub = defaults.TransactionalUnsub
+		case "transactional_default":
+			options.TransactionalDefault = defaults.TransactionalDefault
+		}
+	}
+	return options
+}
+
+func accountOptionsFromModel(model accountOptionsResourceModel) AccountOptions {
+	return AccountOptions{
+		ClickTracking:        boolPointer(model.ClickTracking),
+		OpenTracking:         boolPointer(model.OpenTracking),
+		RestTrackingDefault:  boolPointer(model.RestTrackingDefault),
+		SMTPTrackingDefault:  boolPointer(model.SMTPTrackingDefault),
+		TransactionalUnsub:   boolPointer(model.TransactionalUnsub),
+		TransactionalDefault: boolPointer(model.TransactionalDefault),
+	}
+}
+
+// accountOptionsToModel keeps the configured timeouts, which the API doesn't
+// return
+func accountOptionsToModel(account *Account, configured timeouts.Value) *accountOptionsResourceModel {
+	defaults := DefaultAccountOptions()
+	options := account.Options
+
+	return &accountOptionsResourceModel{
+		ClickTracking:        boolValueOrDefault(options.ClickTracking, defaults.ClickTracking),
+		OpenTracking:         boolValueOrDefault(options.OpenTracking, defaults.OpenTracking),
+		RestTrackingDefault:  boolValueOrDefault(options.RestTrackingDefault, defaults.RestTrackingDefault),
+		SMTPTrackingDefault:  boolValueOrDefault(options.SMTPTrackingDefault, defaults.SMTPTrackingDefault),
+		TransactionalUnsub:   boolValueOrDefault(options.TransactionalUnsub, defaults.TransactionalUnsub),
+		TransactionalDefault: boolValueOrDefault(options.TransactionalDefault, defaults.TransactionalDefault),
+		Id:                   types.StringValue(strconv.Itoa(account.CustomerID)),
+		Timeouts:             configured,
+	}
+}
+
+// boolPointer returns nil for unset values so they are not sent to the API
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := value.ValueBool()
+	return &v
+}
+
+// boolValueOrDefault falls back to the default when the API omits an option
+func boolValueOrDefault(value *bool, fallback *bool) types.Bool {
+	if value != nil {
+		return types.BoolValue(*value)
+	}
+	return types.BoolValue(*fallback)
+}
@@ -0,0 +1,462 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type alertResource struct {
+	client *SparkPostClient
+}
+
+func NewAlertResource() resource.Resource {
+	return &alertResource{}
+}
+
+type alertResourceModel struct {
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Metric             types.String   `tfsdk:"metric"`
+	ThresholdEvaluator types.Object   `tfsdk:"threshold_evaluator"`
+	Filters            types.List     `tfsdk:"filters"`
+	Subaccounts        types.List     `tfsdk:"subaccounts"`
+	Channels           types.Object   `tfsdk:"channels"`
+	Muted              types.Bool     `tfsdk:"muted"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type alertThresholdEvaluatorModel struct {
+	Source   types.String  `tfsdk:"source"`
+	Operator types.String  `tfsdk:"operator"`
+	Value    types.Float64 `tfsdk:"value"`
+}
+
+type alertFilterModel struct {
+	FilterType   types.String `tfsdk:"filter_type"`
+	FilterValues types.List   `tfsdk:"filter_values"`
+}
+
+type alertChannelsModel struct {
+	Emails  types.List   `tfsdk:"emails"`
+	Webhook types.String `tfsdk:"webhook"`
+	Slack   types.String `tfsdk:"slack"`
+}
+
+var alertThresholdEvaluatorAttrTypes = map[string]attr.Type{
+	"source":   types.StringType,
+	"operator": types.StringType,
+	"value":    types.Float64Type,
+}
+
+var alertFilterAttrTypes = map[string]attr.Type{
+	"filter_type":   types.StringType,
+	"filter_values": types.ListType{ElemType: types.StringType},
+}
+
+var alertChannelsAttrTypes = map[string]attr.Type{
+	"emails":  types.ListType{ElemType: types.StringType},
+	"webhook": types.StringType,
+	"slack":   types.StringType,
+}
+
+func (r *alertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+func (r *alertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the alert assigned by SparkPost",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the alert",
+			},
+			"metric": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The metric to alert on, such as `health_score`, `block_bounce_rate` or `monthly_sending_limit`",
+			},
+			"threshold_evaluator": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The condition that triggers the alert",
+				Attributes: map[string]schema.Attribute{
+					"source": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The source of the evaluated value, such as `raw` or `week_over_week`",
+					},
+					"operator": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The comparison operator. Either `gt` or `lt`",
+					},
+					"value": schema.Float64Attribute{
+						Required:            true,
+						MarkdownDescription: "The threshold value the metric is compared against",
+					},
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional filters limiting the data the alert is evaluated on",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"filter_type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of filter, such as `sending_domain` or `ip_pool`",
+						},
+						"filter_values": schema.ListAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The values to filter by",
+						},
+					},
+				},
+			},
+			"subaccounts": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "Optional subaccount IDs the alert applies to. Use `-1` for any subaccount and `0` for the primary account",
+			},
+			"channels": schema.SingleNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Where notifications are sent when the alert triggers",
+				Attributes: map[string]schema.Attribute{
+					"emails": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Optional email addresses to notify",
+					},
+					"webhook": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Optional webhook URL to notify",
+					},
+					"slack": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Optional Slack incoming webhook URL to notify",
+					},
+				},
+			},
+			"muted": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Optional to stop notifications from being sent. Defaults to `false`",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *alertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *alertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	operatorPath := path.Root("threshold_evaluator").AtName("operator")
+
+	var operator types.String
+	diags := req.Config.GetAttribute(ctx, operatorPath, &operator)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || operator.IsNull() || operator.IsUnknown() {
+		return
+	}
+
+	if operator.ValueString() != "gt" && operator.ValueString() != "lt" {
+		resp.Diagnostics.AddAttributeError(
+			operatorPath,
+			"Invalid Configuration",
+			fmt.Sprintf("'%s' is not a valid operator. Must be 'gt' or 'lt'.", operator.ValueString()),
+		)
+	}
+}
+
+func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, diags := alertFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := client.CreateAlert(alert)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(strconv.Itoa(id))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("invalid alert ID '%s'", state.Id.ValueString()))
+		return
+	}
+
+	alert, err := client.GetAlert(id)
+	if err != nil {
+		if err == AlertNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(alert.Name)
+	state.Metric = types.StringValue(alert.Metric)
+	state.Muted = types.BoolValue(alert.Muted)
+
+	resp.Diagnostics.Append(alertRefresh(ctx, &state, alert)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+
+	alert, diags := alertFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("invalid alert ID '%s'", plan.Id.ValueString()))
+		return
+	}
+	alert.ID = id
+
+	err = client.UpdateAlert(alert)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("invalid alert ID '%s'", state.Id.ValueString()))
+		return
+	}
+
+	err = client.DeleteAlert(id)
+	if err != nil && err != AlertNotFound {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func alertFromModel(ctx context.Context, model alertResourceModel) (Alert, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var evaluator alertThresholdEvaluatorModel
+	var channels alertChannelsModel
+	var filters []alertFilterModel
+	var subaccounts []int64
+	diags.Append(model.ThresholdEvaluator.As(ctx, &evaluator, basetypes.ObjectAsOptions{})...)
+	diags.Append(model.Channels.As(ctx, &channels, basetypes.ObjectAsOptions{})...)
+	diags.Append(model.Filters.ElementsAs(ctx, &filters, false)...)
+	diags.Append(model.Subaccounts.ElementsAs(ctx, &subaccounts, false)...)
+	if diags.HasError() {
+		return Alert{}, diags
+	}
+
+	alert := Alert{
+		Name:   model.Name.ValueString(),
+		Metric: model.Metric.ValueString(),
+		ThresholdEvaluator: AlertThresholdEvaluator{
+			Source:   evaluator.Source.ValueString(),
+			Operator: evaluator.Operator.ValueString(),
+			Value:    evaluator.Value.ValueFloat64(),
+		},
+		Filters: []AlertFilter{},
+		Muted:   model.Muted.ValueBool(),
+	}
+
+	diags.Append(channels.Emails.ElementsAs(ctx, &alert.Channels.Emails, false)...)
+
+	for _, filter := range filters {
+		alertFilter := AlertFilter{FilterType: filter.FilterType.ValueString()}
+		diags.Append(filter.FilterValues.ElementsAs(ctx, &alertFilter.FilterValues, false)...)
+		alert.Filters = append(alert.Filters, alertFilter)
+	}
+
+	for _, subaccount := range subaccounts {
+		alert.Subaccounts = append(alert.Subaccounts, int(subaccount))
+	}
+
+	if !channels.Webhook.IsNull() {
+		alert.Channels.Webhook = &AlertWebhookChannel{Target: channels.Webhook.ValueString()}
+	}
+
+	if !channels.Slack.IsNull() {
+		alert.Channels.Slack = &AlertSlackChannel{Target: channels.Slack.ValueString()}
+	}
+
+	return alert, diags
+}
+
+// alertRefresh copies the nested settings of alert into the model. Empty
+// lists and channels from the API are kept null when they were not configured
+func alertRefresh(ctx context.Context, model *alertResourceModel, alert *Alert) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model.ThresholdEvaluator, d = types.ObjectValueFrom(ctx, alertThresholdEvaluatorAttrTypes, alertThresholdEvaluatorModel{
+		Source:   types.StringValue(alert.ThresholdEvaluator.Source),
+		Operator: types.StringValue(alert.ThresholdEvaluator.Operator),
+		Value:    types.Float64Value(alert.ThresholdEvaluator.Value),
+	})
+	diags.Append(d...)
+
+	if len(alert.Filters) > 0 || !model.Filters.IsNull() {
+		filters := []alertFilterModel{}
+		for _, filter := range alert.Filters {
+			values, d := types.ListValueFrom(ctx, types.StringType, filter.FilterValues)
+			diags.Append(d...)
+			filters = append(filters, alertFilterModel{
+				FilterType:   types.StringValue(filter.FilterType),
+				FilterValues: values,
+			})
+		}
+		model.Filters, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: alertFilterAttrTypes}, filters)
+		diags.Append(d...)
+	}
+
+	if len(alert.Subaccounts) > 0 || !model.Subaccounts.IsNull() {
+		subaccounts := []int64{}
+		for _, subaccount := range alert.Subaccounts {
+			subaccounts = append(subaccounts, int64(subaccount))
+		}
+		model.Subaccounts, d = types.ListValueFrom(ctx, types.Int64Type, subaccounts)
+		diags.Append(d...)
+	}
+
+	var channels alertChannelsModel
+	diags.Append(model.Channels.As(ctx, &channels, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(alert.Channels.Emails) > 0 || !channels.Emails.IsNull() {
+		channels.Emails, d = types.ListValueFrom(ctx, types.StringType, append([]string{}, alert.Channels.Emails...))
+		diags.Append(d...)
+	}
+
+	webhook := ""
+	if alert.Channels.Webhook != nil {
+		webhook = alert.Channels.Webhook.Target
+	}
+	channels.Webhook = stringValueOrNull(webhook, channels.Webhook)
+
+	slack := ""
+	if alert.Channels.Slack != nil {
+		slack = alert.Channels.Slack.Target
+	}
+	channels.Slack = stringValueOrNull(slack, channels.Slack)
+
+	model.Channels, d = types.ObjectValueFrom(ctx, alertChannelsAttrTypes, channels)
+	diags.Append(d...)
+
+	return diags
+}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,11 +22,15 @@ func NewDomainResource() resource.Resource {
 }
 
 type domainResourceModel struct {
-	Domain         types.String `tfsdk:"domain"`
-	Subaccount     types.Int64  `tfsdk:"subaccount"`
-	Id             types.String `tfsdk:"id"`
-	Shared         types.Bool   `tfsdk:"shared_with_subaccounts"`
-	DefaultBounce  types.Bool   `tfsdk:"default_bounce_domain"`
+	Domain             types.String   `tfsdk:"domain"`
+	Subaccount         types.Int64    `tfsdk:"subaccount"`
+	SubaccountName     types.String   `tfsdk:"subaccount_name"`
+	Id                 types.String   `tfsdk:"id"`
+	Shared             types.Bool     `tfsdk:"shared_with_subaccounts"`
+	DefaultBounce      types.Bool     `tfsdk:"default_bounce_domain"`
+	DNSRecords         types.List     `tfsdk:"dns_records"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *domainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,35 +43,47 @@ func (r *domainResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"domain": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The domain to be used for sending or bounces",
+				Validators:          domainValidators(),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					domainRequiresReplace(),
 				},
 			},
 			"subaccount": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Optional subaccount ID for creating the tracking domain in",
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
+				Computed:            true,
+				MarkdownDescription: "Optional subaccount ID for creating the tracking domain in. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
 			},
+			"subaccount_name": subaccountNameAttribute(),
 			"shared_with_subaccounts": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Optional to share the domain with all subaccounts. Cannot be used if a subaccount is set",
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},  
 			"default_bounce_domain": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Optional to set as default bounce domain for the account. Cannot be used if a subaccount is set",
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},      
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The domain name used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_records": dnsRecordsAttribute(),
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether Terraform is prevented from deleting the sending domain. Defaults to `true` for new sending domains, while existing ones created by earlier provider versions stay unprotected until it is set. Set it to `false` and apply before destroying or replacing the sending domain",
+				PlanModifiers: []planmodifier.Bool{
+					deletionProtectionDefault{},
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -87,6 +103,20 @@ func (r *domainResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *domainResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *domainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !modifyDefaultSubaccountPlan(ctx, r.client, req, resp) {
+		return
+	}
+
+	validateSharedWithDefaultSubaccount(ctx, req, resp)
+}
+
 func (r *domainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config domainResourceModel
 	diags := req.Config.Get(ctx, &config)
@@ -95,20 +125,22 @@ func (r *domainResource) ValidateConfig(ctx context.Context, req resource.Valida
 		return
 	}
 
-	// If subaccount is explicitly 0, treat as invalid
-	if !config.Subaccount.IsNull() && !config.Subaccount.IsUnknown() && config.Subaccount.ValueInt64() == 0 {
+	// If subaccount is set and shared is explicitly true, it's invalid. A
+	// subaccount of 0 is the primary account, which can share
+	if !config.Subaccount.IsNull() && !config.Subaccount.IsUnknown() && config.Subaccount.ValueInt64() != 0 &&
+		!config.Shared.IsNull() && !config.Shared.IsUnknown() && config.Shared.ValueBool() {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"'subaccount' cannot be 0. Provide a valid subaccount ID or set it to null.",
+			"'subaccount' and 'shared_with_subaccounts = true' cannot both be set. Please specify only one.",
 		)
 	}
 
-	// If subaccount is set and shared is explicitly true, it's invalid
-	if !config.Subaccount.IsNull() && !config.Subaccount.IsUnknown() &&
+	// The same applies when the subaccount is given by name
+	if !config.SubaccountName.IsNull() &&
 		!config.Shared.IsNull() && !config.Shared.IsUnknown() && config.Shared.ValueBool() {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"'subaccount' and 'shared_with_subaccounts = true' cannot both be set. Please specify only one.",
+			"'subaccount_name' and 'shared_with_subaccounts = true' cannot both be set. Please specify only one.",
 		)
 	}
 }
@@ -121,18 +153,36 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(plan.Subaccount.ValueInt64())
-	domain := plan.Domain.ValueString()
+	domain := NormalizeDomainName(plan.Domain.ValueString())
 	shared := plan.Shared.ValueBool()
 	defaultBounce := plan.DefaultBounce.ValueBool()
 
-	err := r.client.CreateDomain(domain, subaccount, shared, defaultBounce)
+	err := client.CreateDomain(domain, subaccount, shared, defaultBounce)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", err.Error())
 		return
 	}
 
-	plan.Id = plan.Domain
+	// Read back the DKIM key SparkPost generated for the DNS records
+	targetDomain, err := client.GetDomain(domain, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("sending domain was created but could not be read back: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(domain)
+	plan.DNSRecords, diags = dnsRecordsValue(SendingDomainDNSRecords(*targetDomain, client.Region()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -146,10 +196,16 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(state.Subaccount.ValueInt64())
 	domain := state.Id.ValueString()
 
-	_, err := r.client.GetDomain(domain, subaccount)
+	targetDomain, err := client.GetDomain(domain, subaccount)
 	if err != nil {
 		if err == DomainNotFound {
 			resp.State.RemoveResource(ctx)
@@ -159,17 +215,99 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Unset flags mean SparkPost's default of false, so they only drift when
+	// the flag has been turned on
+	if targetDomain.SharedWithSubaccounts || !state.Shared.IsNull() {
+		state.Shared = types.BoolValue(targetDomain.SharedWithSubaccounts)
+	}
+
+	lostDefaultBounce := state.DefaultBounce.ValueBool() && !targetDomain.IsDefaultBounceDomain
+	if targetDomain.IsDefaultBounceDomain || !state.DefaultBounce.IsNull() {
+		state.DefaultBounce = types.BoolValue(targetDomain.IsDefaultBounceDomain)
+	}
+
+	if lostDefaultBounce {
+		warnDefaultBounceDomain(client, domain, subaccount, &resp.Diagnostics)
+	}
+
+	state.DNSRecords, diags = dnsRecordsValue(SendingDomainDNSRecords(*targetDomain, client.Region()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"This resource does not support update in-place. To change attributes, the resource must be recreated.",
+// warnDefaultBounceDomain adds a warning naming the domain that took over the
+// default bounce flag from domain
+func warnDefaultBounceDomain(client *SparkPostClient, domain string, subaccount int, diags *diag.Diagnostics) {
+	domains, err := client.ListDomains(subaccount)
+	if err != nil {
+		diags.AddWarning(
+			"Default Bounce Domain Changed",
+			fmt.Sprintf("'%s' is no longer the default bounce domain. The domain now holding the flag could not be determined: %s", domain, err),
+		)
+		return
+	}
+
+	// The flag is held per account and subaccount. Listing for a subaccount
+	// only returns its own domains, listing for the account returns all
+	for _, d := range domains {
+		if d.Domain != domain && d.IsDefaultBounceDomain && (subaccount > 0 || d.SubaccountID == 0) {
+			diags.AddWarning(
+				"Default Bounce Domain Changed",
+				fmt.Sprintf("'%s' is no longer the default bounce domain because '%s' has been marked as the default bounce domain outside of Terraform. Applying will move the flag back to '%s'.", domain, d.Domain, domain),
+			)
+			return
+		}
+	}
+
+	diags.AddWarning(
+		"Default Bounce Domain Changed",
+		fmt.Sprintf("'%s' is no longer the default bounce domain. Applying will mark it as the default bounce domain again.", domain),
 	)
 }
 
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state domainResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to subaccount_name alone resolve to the same subaccount and
+	// need no request
+	if !plan.Shared.Equal(state.Shared) || !plan.DefaultBounce.Equal(state.DefaultBounce) {
+		subaccount := int(plan.Subaccount.ValueInt64())
+		domain := NormalizeDomainName(plan.Domain.ValueString())
+
+		err := client.UpdateDomain(domain, subaccount, plan.Shared.ValueBool(), plan.DefaultBounce.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Update Error", err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state domainResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -178,10 +316,24 @@ func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Sending domain '%s' has deletion protection enabled. Set 'deletion_protection = false' and apply before destroying or replacing it.", state.Id.ValueString()),
+		)
+		return
+	}
+
 	subaccount := int(state.Subaccount.ValueInt64())
 	domain := state.Id.ValueString()
 
-	err := r.client.DeleteDomain(domain, subaccount)
+	err := client.DeleteDomain(domain, subaccount)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,9 +22,11 @@ func NewDomainVerificationResource() resource.Resource {
 }
 
 type domainVerificationResourceModel struct {
-	Domain     types.String `tfsdk:"domain"`
-	Subaccount types.Int64  `tfsdk:"subaccount"`
-	Id         types.String `tfsdk:"id"`
+	Domain         types.String   `tfsdk:"domain"`
+	Subaccount     types.Int64    `tfsdk:"subaccount"`
+	SubaccountName types.String   `tfsdk:"subaccount_name"`
+	Id             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *domainVerificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,21 +39,30 @@ func (r *domainVerificationResource) Schema(ctx context.Context, req resource.Sc
 			"domain": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The domain to be verified",
+				Validators:          domainValidators(),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					domainRequiresReplace(),
 				},
 			},
 			"subaccount": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Optional subnet account ID that contains the domain",
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
+				Computed:            true,
+				MarkdownDescription: "Optional subnet account ID that contains the domain. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
 			},
+			"subaccount_name": subaccountNameAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The domain name used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -71,6 +82,16 @@ func (r *domainVerificationResource) Configure(ctx context.Context, req resource
 	r.client = client
 }
 
+func (r *domainVerificationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *domainVerificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDefaultSubaccountPlan(ctx, r.client, req, resp)
+}
+
 func (r *domainVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan domainVerificationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -79,16 +100,30 @@ func (r *domainVerificationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(plan.Subaccount.ValueInt64())
-	domain := plan.Domain.ValueString()
+	domain := NormalizeDomainName(plan.Domain.ValueString())
 
-	err := r.client.VerifyDomainOwnership(domain, subaccount)
-	if err != nil {
-		resp.Diagnostics.AddError("Create Error", err.Error())
+	// Ownership is verified through the DKIM record. Without the domain there
+	// is nothing to check, and verification reports the actual error
+	var records []DNSRecord
+	if targetDomain, err := client.GetDomain(domain, subaccount); err == nil {
+		records = dnsRecordsWithPurpose(SendingDomainDNSRecords(*targetDomain, client.Region()), "dkim")
+	}
+
+	verifyWithDNSPreflight(ctx, client, "Create Error", records, func() error {
+		return client.VerifyDomainOwnership(domain, subaccount)
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	plan.Id = plan.Domain
+	plan.Id = types.StringValue(domain)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -102,10 +137,16 @@ func (r *domainVerificationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(state.Subaccount.ValueInt64())
 	domain := state.Id.ValueString()
 
-	_, err := r.client.GetDomain(domain, subaccount)
+	_, err := client.GetDomain(domain, subaccount)
 	
 	if err != nil {
 		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
@@ -122,7 +163,17 @@ func (r *domainVerificationResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *domainVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No-op: all changes require replacement
+	// Only subaccount_name can change in place, as it resolves to the same
+	// subaccount. All other changes require replacement
+	var plan domainVerificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *domainVerificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
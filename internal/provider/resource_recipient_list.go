@@ -0,0 +1,519 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type recipientListResource struct {
+	client *SparkPostClient
+}
+
+func NewRecipientListResource() resource.Resource {
+	return &recipientListResource{}
+}
+
+type recipientListResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	Recipients     types.List     `tfsdk:"recipients"`
+	RecipientsCSV  types.String   `tfsdk:"recipients_csv"`
+	Subaccount     types.Int64    `tfsdk:"subaccount"`
+	SubaccountName types.String   `tfsdk:"subaccount_name"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+type recipientListRecipientModel struct {
+	Address          types.String `tfsdk:"address"`
+	Name             types.String `tfsdk:"name"`
+	Tags             types.List   `tfsdk:"tags"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	SubstitutionData types.Map    `tfsdk:"substitution_data"`
+}
+
+var recipientListRecipientAttrTypes = map[string]attr.Type{
+	"address":           types.StringType,
+	"name":              types.StringType,
+	"tags":              types.ListType{ElemType: types.StringType},
+	"metadata":          types.MapType{ElemType: types.StringType},
+	"substitution_data": types.MapType{ElemType: types.StringType},
+}
+
+func (r *recipientListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recipient_list"
+}
+
+func (r *recipientListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional ID of the recipient list. Generated by SparkPost if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the recipient list",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional description of the recipient list",
+			},
+			"recipients": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The recipients in the list. Cannot be used if recipients_csv is set",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The email address of the recipient",
+						},
+						"name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Optional display name of the recipient",
+						},
+						"tags": schema.ListAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Optional tags for the recipient",
+						},
+						"metadata": schema.MapAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Optional metadata for the recipient",
+						},
+						"substitution_data": schema.MapAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Optional substitution data for the recipient",
+						},
+					},
+				},
+			},
+			"recipients_csv": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The recipients in the list in SparkPost CSV format with an `email` column and optional `name`, `tags`, `metadata` and `substitution_data` columns. Recipients changed outside Terraform are detected and shown as a change to this CSV. Cannot be used if recipients is set",
+			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional subaccount ID for creating the recipient list in. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *recipientListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *recipientListResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *recipientListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDefaultSubaccountPlan(ctx, r.client, req, resp)
+}
+
+func (r *recipientListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config recipientListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exactly one of recipients and recipients_csv must be set
+	if !config.Recipients.IsNull() && !config.RecipientsCSV.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"'recipients' and 'recipients_csv' cannot both be set. Please specify only one.",
+		)
+		return
+	}
+
+	if config.Recipients.IsNull() && config.RecipientsCSV.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"One of 'recipients' or 'recipients_csv' must be set.",
+		)
+		return
+	}
+
+	if !config.RecipientsCSV.IsNull() && !config.RecipientsCSV.IsUnknown() {
+		if _, err := ParseRecipientsCSV(config.RecipientsCSV.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("recipients_csv"),
+				"Invalid Recipients CSV",
+				err.Error(),
+			)
+		}
+	}
+
+	if !config.Recipients.IsNull() && !config.Recipients.IsUnknown() {
+		var recipients []recipientListRecipientModel
+		resp.Diagnostics.Append(config.Recipients.ElementsAs(ctx, &recipients, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i, recipient := range recipients {
+			if recipient.Address.IsUnknown() {
+				continue
+			}
+			if err := ValidateRecipientEmail(recipient.Address.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("recipients").AtListIndex(i).AtName("address"),
+					"Invalid Recipient Address",
+					err.Error(),
+				)
+			}
+		}
+	}
+}
+
+func (r *recipientListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recipientListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, diags := recipientListFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	id, err := client.CreateRecipientList(list, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(id)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *recipientListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state recipientListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	id := state.Id.ValueString()
+
+	list, err := client.GetRecipientList(id, subaccount)
+	if err != nil {
+		if err == RecipientListNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(list.Name)
+	state.Description = stringValueOrNull(list.Description, state.Description)
+
+	if !state.Recipients.IsNull() {
+		recipients, diags := recipientListRecipientsToList(ctx, list.Recipients, state.Recipients)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Recipients = recipients
+	}
+
+	// Recipients supplied as CSV are kept as configured while they match the
+	// list, since the CSV formatting cannot be reconstructed from the API
+	// response. Otherwise the CSV is rewritten from the list so the change
+	// shows up in the plan
+	if !state.RecipientsCSV.IsNull() {
+		configured, err := ParseRecipientsCSV(state.RecipientsCSV.ValueString())
+		if err != nil || !RecipientsEqual(configured, list.Recipients) {
+			recipientsCSV, err := FormatRecipientsCSV(list.Recipients)
+			if err != nil {
+				resp.Diagnostics.AddError("Read Error", fmt.Sprintf("failed to format recipients as CSV: %s", err))
+				return
+			}
+			state.RecipientsCSV = types.StringValue(recipientsCSV)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *recipientListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan recipientListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, diags := recipientListFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.UpdateRecipientList(list, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *recipientListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state recipientListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	id := state.Id.ValueString()
+
+	err := client.DeleteRecipientList(id, subaccount)
+	if err != nil && err != RecipientListNotFound {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func recipientListFromModel(ctx context.Context, model recipientListResourceModel) (RecipientList, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	list := RecipientList{
+		ID:          model.Id.ValueString(),
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+	}
+
+	if !model.RecipientsCSV.IsNull() {
+		recipients, err := ParseRecipientsCSV(model.RecipientsCSV.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("recipients_csv"), "Invalid Recipients CSV", err.Error())
+			return list, diags
+		}
+		list.Recipients = recipients
+		return list, diags
+	}
+
+	var recipients []recipientListRecipientModel
+	diags.Append(model.Recipients.ElementsAs(ctx, &recipients, false)...)
+	if diags.HasError() {
+		return list, diags
+	}
+
+	for _, r := range recipients {
+		recipient := Recipient{
+			Address: RecipientAddress{
+				Email: r.Address.ValueString(),
+				Name:  r.Name.ValueString(),
+			},
+		}
+
+		diags.Append(r.Tags.ElementsAs(ctx, &recipient.Tags, false)...)
+
+		var metadata, substitutionData map[string]string
+		diags.Append(r.Metadata.ElementsAs(ctx, &metadata, false)...)
+		diags.Append(r.SubstitutionData.ElementsAs(ctx, &substitutionData, false)...)
+		if diags.HasError() {
+			return list, diags
+		}
+		recipient.Metadata = stringMapToInterfaceMap(metadata)
+		recipient.SubstitutionData = stringMapToInterfaceMap(substitutionData)
+
+		list.Recipients = append(list.Recipients, recipient)
+	}
+
+	return list, diags
+}
+
+// recipientListRecipientsToList converts the recipients returned by the API.
+// The API omits empty tags and maps, so they are kept empty where the prior
+// value of the recipient had them empty rather than null.
+func recipientListRecipientsToList(ctx context.Context, recipients []Recipient, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objectType := types.ObjectType{AttrTypes: recipientListRecipientAttrTypes}
+
+	priorRecipients := map[string]recipientListRecipientModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var models []recipientListRecipientModel
+		diags.Append(prior.ElementsAs(ctx, &models, false)...)
+		if diags.HasError() {
+			return types.ListNull(objectType), diags
+		}
+		for _, model := range models {
+			priorRecipients[model.Address.ValueString()] = model
+		}
+	}
+
+	var objs []attr.Value
+	for _, recipient := range recipients {
+		previous, hasPrevious := priorRecipients[recipient.Address.Email]
+
+		name := types.StringNull()
+		if recipient.Address.Name != "" {
+			name = types.StringValue(recipient.Address.Name)
+		}
+
+		tags := types.ListNull(types.StringType)
+		if len(recipient.Tags) > 0 || (hasPrevious && !previous.Tags.IsNull()) {
+			var d diag.Diagnostics
+			tags, d = types.ListValueFrom(ctx, types.StringType, append([]string{}, recipient.Tags...))
+			diags.Append(d...)
+		}
+
+		metadata, d := interfaceMapToStringMap(recipient.Metadata)
+		diags.Append(d...)
+		if metadata.IsNull() && hasPrevious && !previous.Metadata.IsNull() {
+			metadata = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+		substitutionData, d := interfaceMapToStringMap(recipient.SubstitutionData)
+		diags.Append(d...)
+		if substitutionData.IsNull() && hasPrevious && !previous.SubstitutionData.IsNull() {
+			substitutionData = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+		if diags.HasError() {
+			return types.ListNull(objectType), diags
+		}
+
+		obj, d := types.ObjectValue(recipientListRecipientAttrTypes, map[string]attr.Value{
+			"address":           types.StringValue(recipient.Address.Email),
+			"name":              name,
+			"tags":              tags,
+			"metadata":          metadata,
+			"substitution_data": substitutionData,
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.ListNull(objectType), diags
+		}
+		objs = append(objs, obj)
+	}
+
+	list, d := types.ListValue(objectType, objs)
+	diags.Append(d...)
+	return list, diags
+}
+
+func stringMapToInterfaceMap(values map[string]string) map[string]interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		result[k] = v
+	}
+	return result
+}
+
+// interfaceMapToStringMap converts free-form API values into a string map,
+// encoding any non-string value as JSON
+func interfaceMapToStringMap(values map[string]interface{}) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(values) == 0 {
+		return types.MapNull(types.StringType), diags
+	}
+
+	elements := make(map[string]attr.Value, len(values))
+	for k, v := range values {
+		if s, ok := v.(string); ok {
+			elements[k] = types.StringValue(s)
+			continue
+		}
+
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			diags.AddError("Conversion Error", fmt.Sprintf("failed to encode value of '%s': %s", k, err))
+			return types.MapNull(types.StringType), diags
+		}
+		elements[k] = types.StringValue(string(encoded))
+	}
+
+	result, d := types.MapValue(types.StringType, elements)
+	diags.Append(d...)
+	return result, diags
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type relayWebhookResource struct {
+	client *SparkPostClient
+}
+
+func NewRelayWebhookResource() resource.Resource {
+	return &relayWebhookResource{}
+}
+
+type relayWebhookResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Target            types.String   `tfsdk:"target"`
+	MatchDomain       types.String   `tfsdk:"match_domain"`
+	Protocol          types.String   `tfsdk:"protocol"`
+	AuthToken         types.String   `tfsdk:"auth_token"`
+	OAuthTokenURL     types.String   `tfsdk:"oauth_token_url"`
+	OAuthClientID     types.String   `tfsdk:"oauth_client_id"`
+	OAuthClientSecret types.String   `tfsdk:"oauth_client_secret"`
+	AuthVersion       types.Int64    `tfsdk:"auth_version"`
+	Subaccount        types.Int64    `tfsdk:"subaccount"`
+	SubaccountName    types.String   `tfsdk:"subaccount_name"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *relayWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relay_webhook"
+}
+
+func (r *relayWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the relay webhook assigned by SparkPost",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the relay webhook",
+			},
+			"target": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL that inbound messages are posted to",
+			},
+			"match_domain": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The inbound domain whose messages are relayed to the target",
+				Validators:          domainValidators(),
+			},
+			"protocol": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("SMTP"),
+				MarkdownDescription: "Optional inbound protocol to match. Defaults to `SMTP`",
+			},
+			"auth_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Optional token sent in the `X-MessageSystems-Webhook-Token` header of each request. Write-only, requires Terraform 1.11 or later. Cannot be used if OAuth is configured",
+			},
+			"oauth_token_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional URL to request an OAuth 2.0 access token from",
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional OAuth 2.0 client ID. Required if oauth_token_url is set",
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Optional OAuth 2.0 client secret. Required if oauth_token_url is set. Write-only, requires Terraform 1.11 or later",
+			},
+			"auth_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional version of the write-only credentials. Change it to send updated credentials to SparkPost",
+			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional subaccount ID for creating the relay webhook in. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *relayWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *relayWebhookResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *relayWebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDefaultSubaccountPlan(ctx, r.client, req, resp)
+}
+
+func (r *relayWebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config relayWebhookResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauthSet := !config.OAuthTokenURL.IsNull() || !config.OAuthClientID.IsNull() || !config.OAuthClientSecret.IsNull()
+	oauthComplete := !config.OAuthTokenURL.IsNull() && !config.OAuthClientID.IsNull() && !config.OAuthClientSecret.IsNull()
+
+	if oauthSet && !oauthComplete {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"'oauth_token_url', 'oauth_client_id' and 'oauth_client_secret' must all be set to use OAuth.",
+		)
+	}
+
+	if oauthSet && !config.AuthToken.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"'auth_token' and OAuth cannot both be set. Please specify only one.",
+		)
+	}
+}
+
+func (r *relayWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config relayWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	id, err := client.CreateRelayWebhook(relayWebhookFromModel(plan, config), subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(id)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *relayWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state relayWebhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	id := state.Id.ValueString()
+
+	webhook, err := client.GetRelayWebhook(id, subaccount)
+	if err != nil {
+		if err == RelayWebhookNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(webhook.Name)
+	state.Target = types.StringValue(webhook.Target)
+	if NormalizeDomainName(state.MatchDomain.ValueString()) != webhook.Match.Domain {
+		state.MatchDomain = types.StringValue(webhook.Match.Domain)
+	}
+	state.Protocol = types.StringValue(webhook.Match.Protocol)
+
+	if webhook.AuthRequestDetails != nil {
+		state.OAuthTokenURL = stringValueOrNull(webhook.AuthRequestDetails.URL, state.OAuthTokenURL)
+		state.OAuthClientID = stringValueOrNull(webhook.AuthRequestDetails.Body.ClientID, state.OAuthClientID)
+	} else {
+		state.OAuthTokenURL = types.StringNull()
+		state.OAuthClientID = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *relayWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state, config relayWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+	plan.Id = state.Id
+
+	err := client.UpdateRelayWebhook(relayWebhookFromModel(plan, config), subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *relayWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state relayWebhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	id := state.Id.ValueString()
+
+	err := client.DeleteRelayWebhook(id, subaccount)
+	if err != nil && err != RelayWebhookNotFound {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// relayWebhookFromModel builds the API request from the plan, taking the
+// write-only credentials from the config as they are never part of the plan
+func relayWebhookFromModel(plan relayWebhookResourceModel, config relayWebhookResourceModel) RelayWebhook {
+	webhook := RelayWebhook{
+		ID:        plan.Id.ValueString(),
+		Name:      plan.Name.ValueString(),
+		Target:    plan.Target.ValueString(),
+		AuthToken: config.AuthToken.ValueString(),
+		Match: RelayWebhookMatch{
+			Protocol: plan.Protocol.ValueString(),
+			Domain:   NormalizeDomainName(plan.MatchDomain.ValueString()),
+		},
+	}
+
+	if !plan.OAuthTokenURL.IsNull() {
+		details := &RelayWebhookAuthRequestDetails{URL: plan.OAuthTokenURL.ValueString()}
+		details.Body.ClientID = plan.OAuthClientID.ValueString()
+		details.Body.ClientSecret = config.OAuthClientSecret.ValueString()
+
+		webhook.AuthType = "oauth2"
+		webhook.AuthRequestDetails = details
+	} else {
+		webhook.AuthType = "none"
+	}
+
+	return webhook
+}
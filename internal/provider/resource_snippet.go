@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type snippetResource struct {
+	client *SparkPostClient
+}
+
+func NewSnippetResource() resource.Resource {
+	return &snippetResource{}
+}
+
+type snippetResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	HTML           types.String   `tfsdk:"html"`
+	Text           types.String   `tfsdk:"text"`
+	AMPHTML        types.String   `tfsdk:"amp_html"`
+	Shared         types.Bool     `tfsdk:"shared_with_subaccounts"`
+	Subaccount     types.Int64    `tfsdk:"subaccount"`
+	SubaccountName types.String   `tfsdk:"subaccount_name"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *snippetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snippet"
+}
+
+func (r *snippetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the snippet used to reference it from templates",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the snippet",
+			},
+			"html": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional HTML content of the snippet",
+			},
+			"text": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional text content of the snippet",
+			},
+			"amp_html": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional AMP HTML content of the snippet",
+			},
+			"shared_with_subaccounts": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional to share the snippet with all subaccounts. Cannot be used if a subaccount is set",
+			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional subaccount ID for creating the snippet in. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *snippetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *snippetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *snippetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !modifyDefaultSubaccountPlan(ctx, r.client, req, resp) {
+		return
+	}
+
+	validateSharedWithDefaultSubaccount(ctx, req, resp)
+}
+
+func (r *snippetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config snippetResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If subaccount is set and shared is explicitly true, it's invalid. A
+	// subaccount of 0 is the primary account, which can share
+	if !config.Subaccount.IsNull() && !config.Subaccount.IsUnknown() && config.Subaccount.ValueInt64() != 0 &&
+		!config.Shared.IsNull() && !config.Shared.IsUnknown() && config.Shared.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"'subaccount' and 'shared_with_subaccounts = true' cannot both be set. Please specify only one.",
+		)
+	}
+
+	// The same applies when the subaccount is given by name
+	if !config.SubaccountName.IsNull() &&
+		!config.Shared.IsNull() && !config.Shared.IsUnknown() && config.Shared.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"'subaccount_name' and 'shared_with_subaccounts = true' cannot both be set. Please specify only one.",
+		)
+	}
+
+	// At least one kind of content is required
+	if config.HTML.IsNull() && config.Text.IsNull() && config.AMPHTML.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"At least one of 'html', 'text' or 'amp_html' must be set.",
+		)
+	}
+}
+
+func (r *snippetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snippetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.CreateSnippet(snippetFromModel(plan), subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *snippetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state snippetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	id := state.Id.ValueString()
+
+	snippet, err := client.GetSnippet(id, subaccount)
+	if err != nil {
+		if err == SnippetNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(snippet.Name)
+	state.HTML = stringValueOrNull(snippet.Content.HTML, state.HTML)
+	state.Text = stringValueOrNull(snippet.Content.Text, state.Text)
+	state.AMPHTML = stringValueOrNull(snippet.Content.AMPHTML, state.AMPHTML)
+	if snippet.Shared || !state.Shared.IsNull() {
+		state.Shared = types.BoolValue(snippet.Shared)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *snippetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan snippetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.UpdateSnippet(snippetFromModel(plan), subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *snippetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state snippetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	id := state.Id.ValueString()
+
+	err := client.DeleteSnippet(id, subaccount)
+	if err != nil && err != SnippetNotFound {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func snippetFromModel(model snippetResourceModel) Snippet {
+	return Snippet{
+		ID:   model.Id.ValueString(),
+		Name: model.Name.ValueString(),
+		Content: SnippetContent{
+			HTML:    model.HTML.ValueString(),
+			Text:    model.Text.ValueString(),
+			AMPHTML: model.AMPHTML.ValueString(),
+		},
+		Shared: model.Shared.ValueBool(),
+	}
+}
+
+// stringValueOrNull keeps an unset optional attribute null when the API
+// returns an empty value for it
+func stringValueOrNull(value string, prior types.String) types.String {
+	if value == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
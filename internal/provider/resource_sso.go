@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ssoResource struct {
+	client *SparkPostClient
+}
+
+func NewSSOResource() resource.Resource {
+	return &ssoResource{}
+}
+
+type ssoResourceModel struct {
+	IDPMetadata types.String   `tfsdk:"idp_metadata"`
+	EnforceSSO  types.Bool     `tfsdk:"enforce_sso"`
+	DefaultRole types.String   `tfsdk:"default_role"`
+	Id          types.String   `tfsdk:"id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ssoResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso"
+}
+
+func (r *ssoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages SAML single sign-on for the account. Only one instance should exist per account",
+		Attributes: map[string]schema.Attribute{
+			"idp_metadata": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The SAML 2.0 metadata XML of the identity provider. Validated before it is uploaded",
+			},
+			"enforce_sso": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Optional to require all users to sign in through SSO. SSO is enabled whether or not it is enforced. Defaults to `false`",
+			},
+			"default_role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional access level given to users provisioned on their first SSO sign in, such as `reporting` or `developer`",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A static identifier used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ssoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ssoResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ssoResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IDPMetadata.IsNull() && !config.IDPMetadata.IsUnknown() {
+		if err := ValidateSAMLMetadata(config.IDPMetadata.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("idp_metadata"), "Invalid SAML Metadata", err.Error())
+		}
+	}
+}
+
+func (r *ssoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ssoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.UpdateSSOConfig(plan.IDPMetadata.ValueString(), ssoConfigFromModel(plan, nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	enforce := plan.EnforceSSO.ValueBool()
+	err = client.UpdateAccountOptions(AccountOptions{EnforceSSO: &enforce})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("SSO was configured but enforcement could not be set: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue("saml")
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ssoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ssoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := client.GetSSOConfig()
+	if err != nil {
+		if err == SSOConfigNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	account, err := client.GetAccount(false)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	// The metadata is not returned by the API, so the configured value is kept
+	state.EnforceSSO = types.BoolValue(account.Options.EnforceSSO != nil && *account.Options.EnforceSSO)
+	state.DefaultRole = stringValueOrNull(config.DefaultRole, state.DefaultRole)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ssoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ssoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ssoResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.UpdateSSOConfig(plan.IDPMetadata.ValueString(), ssoConfigFromModel(plan, &state))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	enforce := plan.EnforceSSO.ValueBool()
+	err = client.UpdateAccountOptions(AccountOptions{EnforceSSO: &enforce})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("SSO was configured but enforcement could not be set: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue("saml")
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ssoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ssoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Enforcement is lifted first so users are not locked out once SSO is
+	// removed
+	if state.EnforceSSO.ValueBool() {
+		enforce := false
+		err := client.UpdateAccountOptions(AccountOptions{EnforceSSO: &enforce})
+		if err != nil {
+			resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("SSO enforcement could not be lifted: %s", err))
+			return
+		}
+	}
+
+	err := client.DeleteSSOConfig()
+	if err != nil && err != SSOConfigNotFound {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ssoConfigFromModel builds the SAML configuration. A default role set in the
+// prior state but removed from the model is cleared.
+func ssoConfigFromModel(model ssoResourceModel, prior *ssoResourceModel) SSOConfig {
+	return SSOConfig{
+		Enabled:          true,
+		DefaultRole:      model.DefaultRole.ValueString(),
+		ClearDefaultRole: prior != nil && !prior.DefaultRole.IsNull() && model.DefaultRole.IsNull(),
+	}
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type subaccountSettingsResource struct {
+	client *SparkPostClient
+}
+
+func NewSubaccountSettingsResource() resource.Resource {
+	return &subaccountSettingsResource{}
+}
+
+type subaccountSettingsResourceModel struct {
+	Subaccount          types.Int64    `tfsdk:"subaccount"`
+	SubaccountName      types.String   `tfsdk:"subaccount_name"`
+	Name                types.String   `tfsdk:"name"`
+	Status              types.String   `tfsdk:"status"`
+	IPPool              types.String   `tfsdk:"ip_pool"`
+	DailySendingLimit   types.Int64    `tfsdk:"daily_sending_limit"`
+	MonthlySendingLimit types.Int64    `tfsdk:"monthly_sending_limit"`
+	ComplianceStatus    types.String   `tfsdk:"compliance_status"`
+	Id                  types.String   `tfsdk:"id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *subaccountSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_settings"
+}
+
+func (r *subaccountSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of an existing subaccount. Destroying it leaves the subaccount and its current settings unchanged",
+		Attributes: map[string]schema.Attribute{
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the subaccount to manage. Either subaccount or subaccount_name must be set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional name of the subaccount. Left unchanged if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional status of the subaccount. Either `active`, `suspended` or `terminated`. Left unchanged if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_pool": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional default IP pool for messages sent by the subaccount. Left unchanged if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"daily_sending_limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional maximum number of messages the subaccount can send per day. Removing it removes the limit",
+			},
+			"monthly_sending_limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Optional maximum number of messages the subaccount can send per month. Removing it removes the limit",
+			},
+			"compliance_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The compliance status of the subaccount as set by SparkPost",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subaccount ID used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *subaccountSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *subaccountSettingsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *subaccountSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifySubaccountPlan(ctx, r.client, req, resp)
+}
+
+func (r *subaccountSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subaccountSettingsResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Subaccount.IsNull() && config.SubaccountName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"One of 'subaccount' or 'subaccount_name' must be set.",
+		)
+	}
+
+	if !config.Status.IsNull() && !config.Status.IsUnknown() {
+		switch config.Status.ValueString() {
+		case "active", "suspended", "terminated":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid Configuration",
+				fmt.Sprintf("'%s' is not a valid status. Must be 'active', 'suspended' or 'terminated'.", config.Status.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *subaccountSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subaccountSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.UpdateSubaccount(subaccount, subaccountUpdateFromModel(plan, nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	live, err := client.GetSubaccount(subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	subaccountSettingsRefresh(&plan, live)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subaccountSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subaccountSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+
+	live, err := client.GetSubaccount(subaccount)
+	if err != nil {
+		if err == SubaccountNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	subaccountSettingsRefresh(&state, live)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subaccountSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state subaccountSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.UpdateSubaccount(subaccount, subaccountUpdateFromModel(plan, &state))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	live, err := client.GetSubaccount(subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	subaccountSettingsRefresh(&plan, live)
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *subaccountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No-op: subaccounts cannot be deleted and the settings are left as they are
+	resp.State.RemoveResource(ctx)
+}
+
+// subaccountUpdateFromModel builds the update for model. Sending limits that
+// prior had and model no longer has are cleared
+func subaccountUpdateFromModel(model subaccountSettingsResourceModel, prior *subaccountSettingsResourceModel) SubaccountUpdate {
+	var update SubaccountUpdate
+
+	if !model.Name.IsNull() && !model.Name.IsUnknown() {
+		name := model.Name.ValueString()
+		update.Name = &name
+	}
+	if !model.Status.IsNull() && !model.Status.IsUnknown() {
+		status := model.Status.ValueString()
+		update.Status = &status
+	}
+	if !model.IPPool.IsNull() && !model.IPPool.IsUnknown() {
+		ipPool := model.IPPool.ValueString()
+		update.IPPool = &ipPool
+	}
+
+	limits := &SubaccountSendingLimits{}
+	if !model.DailySendingLimit.IsNull() {
+		daily := int(model.DailySendingLimit.ValueInt64())
+		limits.Daily = &daily
+	}
+	if !model.MonthlySendingLimit.IsNull() {
+		monthly := int(model.MonthlySendingLimit.ValueInt64())
+		limits.Monthly = &monthly
+	}
+	if prior != nil {
+		limits.ClearDaily = limits.Daily == nil && !prior.DailySendingLimit.IsNull()
+		limits.ClearMonthly = limits.Monthly == nil && !prior.MonthlySendingLimit.IsNull()
+	}
+	if limits.Daily != nil || limits.Monthly != nil || limits.ClearDaily || limits.ClearMonthly {
+		update.Options = &SubaccountOptions{SendingLimits: limits}
+	}
+
+	return update
+}
+
+// subaccountSettingsRefresh copies the live settings into the model. The
+// sending limits are only refreshed when they are managed.
+func subaccountSettingsRefresh(model *subaccountSettingsResourceModel, live *Subaccount) {
+	model.Name = types.StringValue(live.Name)
+	model.Status = types.StringValue(live.Status)
+	model.IPPool = types.StringValue(live.IPPool)
+	model.ComplianceStatus = types.StringValue(live.ComplianceStatus)
+	model.Id = types.StringValue(strconv.Itoa(live.ID))
+
+	limits := live.Options.SendingLimits
+	if limits == nil {
+		limits = &SubaccountSendingLimits{}
+	}
+	if !model.DailySendingLimit.IsNull() {
+		model.DailySendingLimit = intPointerValue(limits.Daily)
+	}
+	if !model.MonthlySendingLimit.IsNull() {
+		model.MonthlySendingLimit = intPointerValue(limits.Monthly)
+	}
+}
+
+func intPointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type suppressionListResource struct {
+	client *SparkPostClient
+}
+
+func NewSuppressionListResource() resource.Resource {
+	return &suppressionListResource{}
+}
+
+type suppressionListResourceModel struct {
+	Entries        types.Set      `tfsdk:"entries"`
+	Subaccount     types.Int64    `tfsdk:"subaccount"`
+	SubaccountName types.String   `tfsdk:"subaccount_name"`
+	Id             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+type suppressionListEntryModel struct {
+	Recipient   types.String `tfsdk:"recipient"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+var suppressionListEntryAttrTypes = map[string]attr.Type{
+	"recipient":   types.StringType,
+	"type":        types.StringType,
+	"description": types.StringType,
+}
+
+func (r *suppressionListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suppression_list"
+}
+
+func (r *suppressionListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"entries": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The suppression entries managed by this resource. Entries added outside of Terraform are left untouched",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"recipient": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The email address to be suppressed",
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The type of suppression. Either `transactional` or `non_transactional`",
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Optional description of why the recipient is suppressed",
+						},
+					},
+				},
+			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional subaccount ID for managing the suppression list of. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the suppression list used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *suppressionListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *suppressionListResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *suppressionListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDefaultSubaccountPlan(ctx, r.client, req, resp)
+}
+
+func (r *suppressionListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config suppressionListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Entries.IsNull() || config.Entries.IsUnknown() {
+		return
+	}
+
+	var entries []suppressionListEntryModel
+	resp.Diagnostics.Append(config.Entries.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		if entry.Recipient.IsUnknown() || entry.Type.IsUnknown() {
+			continue
+		}
+
+		if err := ValidateRecipientEmail(entry.Recipient.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("entries"), "Invalid Recipient", err.Error())
+		}
+		if err := ValidateSuppressionType(entry.Type.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("entries"), "Invalid Suppression Type", err.Error())
+		}
+
+		key := suppressionListKey(Suppression{Recipient: entry.Recipient.ValueString(), Type: entry.Type.ValueString()})
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entries"),
+				"Duplicate Suppression Entry",
+				fmt.Sprintf("'%s' is listed more than once with type '%s'.", entry.Recipient.ValueString(), entry.Type.ValueString()),
+			)
+		}
+		seen[key] = true
+	}
+}
+
+func (r *suppressionListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan suppressionListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := suppressionListEntries(ctx, plan.Entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.PutSuppressions(entries, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(suppressionListID(subaccount))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *suppressionListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state suppressionListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := suppressionListEntries(ctx, state.Entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+
+	// Look up each recipient once, as a lookup returns entries of all types
+	live := map[string]Suppression{}
+	looked := map[string]bool{}
+	for _, entry := range entries {
+		recipient := strings.ToLower(entry.Recipient)
+		if looked[recipient] {
+			continue
+		}
+		looked[recipient] = true
+
+		found, err := client.GetSuppressions(entry.Recipient, subaccount)
+		if err != nil && err != SuppressionNotFound {
+			resp.Diagnostics.AddError("Read Error", err.Error())
+			return
+		}
+		for _, suppression := range found {
+			live[suppressionListKey(suppression)] = suppression
+		}
+	}
+
+	var objs []attr.Value
+	for _, entry := range entries {
+		suppression, ok := live[suppressionListKey(entry)]
+		if !ok {
+			continue
+		}
+
+		description := types.StringValue(suppression.Description)
+		if suppression.Description == "" && entry.Description == "" {
+			description = types.StringNull()
+		}
+
+		obj, d := types.ObjectValue(suppressionListEntryAttrTypes, map[string]attr.Value{
+			"recipient":   types.StringValue(entry.Recipient),
+			"type":        types.StringValue(entry.Type),
+			"description": description,
+		})
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		objs = append(objs, obj)
+	}
+
+	setVal, d := types.SetValue(types.ObjectType{AttrTypes: suppressionListEntryAttrTypes}, objs)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Entries = setVal
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *suppressionListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state suppressionListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := suppressionListEntries(ctx, plan.Entries)
+	resp.Diagnostics.Append(diags...)
+	current, diags := suppressionListEntries(ctx, state.Entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	existing := map[string]Suppression{}
+	for _, entry := range current {
+		existing[suppressionListKey(entry)] = entry
+	}
+
+	// Only send entries that are new or have a changed description
+	var changed []Suppression
+	wanted := map[string]bool{}
+	for _, entry := range planned {
+		key := suppressionListKey(entry)
+		wanted[key] = true
+		if old, ok := existing[key]; !ok || old.Description != entry.Description {
+			changed = append(changed, entry)
+		}
+	}
+
+	err := client.PutSuppressions(changed, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	for _, entry := range current {
+		if wanted[suppressionListKey(entry)] {
+			continue
+		}
+		err := client.DeleteSuppression(entry.Recipient, entry.Type, subaccount)
+		if err != nil && err != SuppressionNotFound {
+			resp.Diagnostics.AddError("Update Error", err.Error())
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(suppressionListID(subaccount))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *suppressionListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state suppressionListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, diags := suppressionListEntries(ctx, state.Entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+
+	for _, entry := range entries {
+		err := client.DeleteSuppression(entry.Recipient, entry.Type, subaccount)
+		if err != nil && err != SuppressionNotFound {
+			resp.Diagnostics.AddError("Delete Error", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// suppressionListKey identifies a suppression by recipient, which SparkPost
+// compares case-insensitively, and type
+func suppressionListKey(suppression Suppression) string {
+	return strings.ToLower(suppression.Recipient) + " " + suppression.Type
+}
+
+func suppressionListEntries(ctx context.Context, set types.Set) ([]Suppression, diag.Diagnostics) {
+	var models []suppressionListEntryModel
+	diags := set.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	entries := make([]Suppression, 0, len(models))
+	for _, model := range models {
+		entries = append(entries, Suppression{
+			Recipient:   model.Recipient.ValueString(),
+			Type:        model.Type.ValueString(),
+			Description: model.Description.ValueString(),
+		})
+	}
+
+	return entries, diags
+}
+
+func suppressionListID(subaccount int) string {
+	if subaccount > 0 {
+		return fmt.Sprintf("suppression-list/%d", subaccount)
+	}
+	return "suppression-list"
+}
@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,10 +25,17 @@ func NewTrackingDomainResource() resource.Resource {
 }
 
 type trackingDomainResourceModel struct {
-	Domain     types.String `tfsdk:"domain"`
-	HTTPS      types.Bool   `tfsdk:"https"`
-	Subaccount types.Int64  `tfsdk:"subaccount"`
-	Id         types.String `tfsdk:"id"`
+	Domain             types.String   `tfsdk:"domain"`
+	HTTPS              types.Bool     `tfsdk:"https"`
+	Port               types.Int64    `tfsdk:"port"`
+	Default            types.Bool     `tfsdk:"default"`
+	Subaccount         types.Int64    `tfsdk:"subaccount"`
+	SubaccountName     types.String   `tfsdk:"subaccount_name"`
+	Id                 types.String   `tfsdk:"id"`
+	DNSRecords         types.List     `tfsdk:"dns_records"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	ForceDisassociate  types.Bool     `tfsdk:"force_disassociate"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *trackingDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,8 +48,9 @@ func (r *trackingDomainResource) Schema(ctx context.Context, req resource.Schema
 			"domain": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The domain to be used for tracking links",
+				Validators:          domainValidators(),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					domainRequiresReplace(),
 				},
 			},
 			"https": schema.BoolAttribute{
@@ -46,17 +58,56 @@ func (r *trackingDomainResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "Specifies if the domain should use HTTPS",
 				Computed:            false,
 			},
-			"subaccount": schema.Int64Attribute{
+			"port": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Optional subnet account ID for creating the tracking domain in",
+				Computed:            true,
+				MarkdownDescription: "Optional port the tracking links use. Defaults to `443` when https is enabled and `80` otherwise, also when it is removed from the configuration",
 				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+					trackingDomainPortDefault{},
+				},
+			},
+			"default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional to make this the default tracking domain of the account, or of the subaccount if one is set. Removing it keeps the current value, as SparkPost only changes the default when another tracking domain is made the default",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional subnet account ID for creating the tracking domain in. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The domain name used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_records": dnsRecordsAttribute(),
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether Terraform is prevented from deleting the tracking domain. Defaults to `true` for new tracking domains, while existing ones created by earlier provider versions stay unprotected until it is set. Set it to `false` and apply before destroying or replacing the tracking domain",
+				PlanModifiers: []planmodifier.Bool{
+					deletionProtectionDefault{},
+				},
 			},
+			"force_disassociate": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether deleting the tracking domain first removes it from the sending domains that use it. Otherwise deletion fails while sending domains use it. Defaults to `false`",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -76,6 +127,79 @@ func (r *trackingDomainResource) Configure(ctx context.Context, req resource.Con
 	r.client = client
 }
 
+func (r *trackingDomainResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *trackingDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDefaultSubaccountPlan(ctx, r.client, req, resp)
+}
+
+func (r *trackingDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config trackingDomainResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Port.IsNull() && !config.Port.IsUnknown() {
+		if port := config.Port.ValueInt64(); port < 1 || port > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port"),
+				"Invalid Configuration",
+				fmt.Sprintf("'port' must be between 1 and 65535, got %d.", port),
+			)
+		}
+	}
+}
+
+// trackingDomainPortDefault plans the port SparkPost uses when none is
+// configured, so removing port from the configuration reverts it instead of
+// keeping the previous value
+type trackingDomainPortDefault struct{}
+
+func (m trackingDomainPortDefault) Description(ctx context.Context) string {
+	return "Defaults to 443 when https is enabled and 80 otherwise"
+}
+
+func (m trackingDomainPortDefault) MarkdownDescription(ctx context.Context) string {
+	return "Defaults to `443` when https is enabled and `80` otherwise"
+}
+
+func (m trackingDomainPortDefault) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var https types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("https"), &https)...)
+	if resp.Diagnostics.HasError() || https.IsUnknown() {
+		return
+	}
+
+	if https.ValueBool() {
+		resp.PlanValue = types.Int64Value(443)
+	} else {
+		resp.PlanValue = types.Int64Value(80)
+	}
+}
+
+// trackingDomainOptions returns the optional settings configured in the plan
+func trackingDomainOptions(plan trackingDomainResourceModel) TrackingDomainOptions {
+	var options TrackingDomainOptions
+	if !plan.Port.IsNull() && !plan.Port.IsUnknown() {
+		options.Port = int(plan.Port.ValueInt64())
+	}
+	if !plan.Default.IsNull() && !plan.Default.IsUnknown() {
+		isDefault := plan.Default.ValueBool()
+		options.Default = &isDefault
+	}
+	return options
+}
+
 func (r *trackingDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan trackingDomainResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -84,17 +208,37 @@ func (r *trackingDomainResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(plan.Subaccount.ValueInt64())
-	domain := plan.Domain.ValueString()
+	domain := NormalizeDomainName(plan.Domain.ValueString())
 	https := plan.HTTPS.ValueBool()
 
-	err := r.client.CreateTrackingDomain(domain, https, subaccount)
+	err := client.CreateTrackingDomain(domain, https, trackingDomainOptions(plan), subaccount)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", err.Error())
 		return
 	}
 
-	plan.Id = plan.Domain
+	// Read back the port and default flag SparkPost picked
+	trackingDomain, err := client.GetTrackingDomain(domain, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("tracking domain was created but could not be read back: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(domain)
+	plan.Port = types.Int64Value(int64(trackingDomain.Port))
+	plan.Default = types.BoolValue(trackingDomain.Default)
+	plan.DNSRecords, diags = dnsRecordsValue(TrackingDomainDNSRecords(domain, client.Region()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -108,10 +252,16 @@ func (r *trackingDomainResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(state.Subaccount.ValueInt64())
 	domain := state.Id.ValueString()
 
-	_, err := r.client.GetTrackingDomain(domain, subaccount)
+	trackingDomain, err := client.GetTrackingDomain(domain, subaccount)
 	if err != nil {
 		if err == TrackingDomainNotFound {
 			resp.State.RemoveResource(ctx)
@@ -121,31 +271,73 @@ func (r *trackingDomainResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// An unset https means SparkPost's default of false, so it only drifts
+	// when the domain has been switched to HTTPS
+	if trackingDomain.Secure || !state.HTTPS.IsNull() {
+		state.HTTPS = types.BoolValue(trackingDomain.Secure)
+	}
+	state.Port = types.Int64Value(int64(trackingDomain.Port))
+	state.Default = types.BoolValue(trackingDomain.Default)
+	state.DNSRecords, diags = dnsRecordsValue(TrackingDomainDNSRecords(domain, client.Region()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *trackingDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-    var plan trackingDomainResourceModel
-    diags := req.Plan.Get(ctx, &plan)
-    resp.Diagnostics.Append(diags...)
-    if resp.Diagnostics.HasError() {
-        return
-    }
+	var plan, state trackingDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-    subaccount := int(plan.Subaccount.ValueInt64())
-    domain := plan.Domain.ValueString()
-    https := plan.HTTPS.ValueBool()
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(plan.Subaccount.ValueInt64())
+	domain := NormalizeDomainName(plan.Domain.ValueString())
+	https := plan.HTTPS.ValueBool()
 
-    err := r.client.UpdateTrackingDomain(domain, https, subaccount)
-    if err != nil {
-        resp.Diagnostics.AddError("Update Error", err.Error())
-        return
-    }
+	plan.Id = types.StringValue(domain)
+
+	// Changes to deletion_protection, force_disassociate or subaccount_name
+	// alone need no request. An unknown port or default flag is left to
+	// SparkPost, so it keeps its current value
+	if plan.HTTPS.Equal(state.HTTPS) &&
+		(plan.Port.IsUnknown() || plan.Port.Equal(state.Port)) &&
+		(plan.Default.IsUnknown() || plan.Default.Equal(state.Default)) {
+		plan.Port = state.Port
+		plan.Default = state.Default
+		diags := resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	err := client.UpdateTrackingDomain(domain, https, trackingDomainOptions(plan), subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	// Read back the port and default flag in case SparkPost changed them
+	trackingDomain, err := client.GetTrackingDomain(domain, subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("tracking domain was updated but could not be read back: %s", err))
+		return
+	}
 
-    plan.Id = types.StringValue(domain)
-    diags = resp.State.Set(ctx, &plan)
-    resp.Diagnostics.Append(diags...)
+	plan.Port = types.Int64Value(int64(trackingDomain.Port))
+	plan.Default = types.BoolValue(trackingDomain.Default)
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *trackingDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -156,11 +348,67 @@ func (r *trackingDomainResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Tracking domain '%s' has deletion protection enabled. Set 'deletion_protection = false' and apply before destroying or replacing it.", state.Id.ValueString()),
+		)
+		return
+	}
+
 	subaccount := int(state.Subaccount.ValueInt64())
 	domain := state.Id.ValueString()
 
-	err := r.client.DeleteTrackingDomain(domain, subaccount)
+	// SparkPost refuses to delete tracking domains that sending domains use
+	domains, err := client.ListDomains(subaccount)
 	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("could not list the sending domains using the tracking domain: %s", err))
+		return
+	}
+
+	var blocking []TargetDomain
+	for _, d := range domains {
+		if d.TrackingDomain == domain {
+			blocking = append(blocking, d)
+		}
+	}
+
+	if len(blocking) > 0 && !state.ForceDisassociate.ValueBool() {
+		names := make([]string, 0, len(blocking))
+		for _, d := range blocking {
+			names = append(names, d.Domain)
+		}
+		resp.Diagnostics.AddError(
+			"Tracking Domain In Use",
+			fmt.Sprintf("Tracking domain '%s' is used by the sending domains %s. Remove their tracking domain associations first, "+
+				"or set 'force_disassociate = true' and apply to remove them when deleting the tracking domain.", domain, "'"+strings.Join(names, "', '")+"'"),
+		)
+		return
+	}
+
+	for _, d := range blocking {
+		// Listing for the account also returns the domains of subaccounts
+		domainSubaccount := subaccount
+		if domainSubaccount == 0 {
+			domainSubaccount = d.SubaccountID
+		}
+
+		err := client.AssociateTrackingDomain(d.Domain, domainSubaccount, "")
+		if err != nil && err != DomainNotFound {
+			resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("could not remove the tracking domain from sending domain '%s': %s", d.Domain, err))
+			return
+		}
+	}
+
+	// Already deleted outside of Terraform
+	err = client.DeleteTrackingDomain(domain, subaccount)
+	if err != nil && err != TrackingDomainNotFound {
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
 	}
@@ -1,16 +1,16 @@
-﻿package provider
+package provider
 
 import (
 	"context"
 	"fmt"
-	
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 type trackingDomainAssociationResource struct {
@@ -22,10 +22,12 @@ func NewTrackingDomainAssociationResource() resource.Resource {
 }
 
 type trackingDomainAssociationResourceModel struct {
-	Domain         types.String `tfsdk:"domain"`
-	TrackingDomain types.String  `tfsdk:"tracking_domain"`
-	Subaccount     types.Int64  `tfsdk:"subaccount"`
-	Id             types.String `tfsdk:"id"`
+	Domain         types.String   `tfsdk:"domain"`
+	TrackingDomain types.String   `tfsdk:"tracking_domain"`
+	Subaccount     types.Int64    `tfsdk:"subaccount"`
+	SubaccountName types.String   `tfsdk:"subaccount_name"`
+	Id             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *trackingDomainAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -38,28 +40,35 @@ func (r *trackingDomainAssociationResource) Schema(ctx context.Context, req reso
 			"domain": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The domain to be associated",
+				Validators:          domainValidators(),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					domainRequiresReplace(),
 				},
 			},
 			"tracking_domain": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The tracking domain to be associated",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},        
+				MarkdownDescription: "The tracking domain to be associated. Changing it updates the association in place",
+				Validators:          domainValidators(),
+			},
 			"subaccount": schema.Int64Attribute{
 				Optional:            true,
-				MarkdownDescription: "Optional subnet account ID that contains the domain",
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
+				Computed:            true,
+				MarkdownDescription: "Optional subnet account ID that contains the domain. Defaults to the provider-level subaccount when created. Set to `0` for the primary account. Cannot be used if subaccount_name is set",
 			},
+			"subaccount_name": subaccountNameAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The domain name used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -79,6 +88,17 @@ func (r *trackingDomainAssociationResource) Configure(ctx context.Context, req r
 	r.client = client
 }
 
+func (r *trackingDomainAssociationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+		differentDomainsValidator{first: "domain", second: "tracking_domain"},
+	}
+}
+
+func (r *trackingDomainAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyDefaultSubaccountPlan(ctx, r.client, req, resp)
+}
+
 func (r *trackingDomainAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan trackingDomainAssociationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -87,17 +107,23 @@ func (r *trackingDomainAssociationResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(plan.Subaccount.ValueInt64())
-	domain := plan.Domain.ValueString()
-	trackingDomain := plan.TrackingDomain.ValueString()
+	domain := NormalizeDomainName(plan.Domain.ValueString())
+	trackingDomain := NormalizeDomainName(plan.TrackingDomain.ValueString())
 
-	err := r.client.AssociateTrackingDomain(domain, subaccount, trackingDomain)
+	err := client.AssociateTrackingDomain(domain, subaccount, trackingDomain)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", err.Error())
 		return
 	}
 
-	plan.Id = plan.Domain
+	plan.Id = types.StringValue(domain)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -111,30 +137,76 @@ func (r *trackingDomainAssociationResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subaccount := int(state.Subaccount.ValueInt64())
 	domain := state.Id.ValueString()
-	trackingDomain := state.TrackingDomain.ValueString()
+	trackingDomain := NormalizeDomainName(state.TrackingDomain.ValueString())
 
-	actualTrackingDomain, err := r.client.GetTrackingDomainAssociation(domain, subaccount, trackingDomain)
+	actualTrackingDomain, err := client.GetTrackingDomainAssociation(domain, subaccount, trackingDomain)
 	if err != nil {
+		// The association goes away with the sending domain
+		if err == DomainNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Read Error", err.Error())
 		return
 	}
 
-    if actualTrackingDomain != trackingDomain {
-    	resp.Diagnostics.AddWarning(
-    		"Tracking Domain Mismatch",
-    		fmt.Sprintf("The current tracking domain '%s' does not match the configured value '%s'. "+
-    			"This may indicate it was edited outside of Terraform.", actualTrackingDomain, trackingDomain),
-    	)
-    }
+	if actualTrackingDomain != trackingDomain {
+		resp.Diagnostics.AddWarning(
+			"Tracking Domain Mismatch",
+			fmt.Sprintf("The current tracking domain '%s' does not match the configured value '%s'. "+
+				"This may indicate it was edited outside of Terraform.", actualTrackingDomain, trackingDomain),
+		)
 
-    diags = resp.State.SetAttribute(ctx, path.Root("tracking_domain"), types.StringValue(actualTrackingDomain))
-    resp.Diagnostics.Append(diags...)
+		diags = resp.State.SetAttribute(ctx, path.Root("tracking_domain"), types.StringValue(actualTrackingDomain))
+		resp.Diagnostics.Append(diags...)
+	}
 }
 
 func (r *trackingDomainAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// No-op: all changes require replacement
+	var plan trackingDomainAssociationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state trackingDomainAssociationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Switch the tracking domain with a single request, so links are never
+	// left without tracking. Changes to subaccount_name alone need no request
+	if NormalizeDomainName(plan.TrackingDomain.ValueString()) != NormalizeDomainName(state.TrackingDomain.ValueString()) {
+		subaccount := int(plan.Subaccount.ValueInt64())
+		domain := NormalizeDomainName(plan.Domain.ValueString())
+		trackingDomain := NormalizeDomainName(plan.TrackingDomain.ValueString())
+
+		err := client.AssociateTrackingDomain(domain, subaccount, trackingDomain)
+		if err != nil {
+			resp.Diagnostics.AddError("Update Error", err.Error())
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *trackingDomainAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -145,14 +217,21 @@ func (r *trackingDomainAssociationResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-    subaccount := int(state.Subaccount.ValueInt64())
-    domain := state.Domain.ValueString()
-    
-    err := r.client.AssociateTrackingDomain(domain, subaccount, "")
-    if err != nil {
-    	resp.Diagnostics.AddError("Delete Error", err.Error())
-    	return
-    }    
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := int(state.Subaccount.ValueInt64())
+	domain := NormalizeDomainName(state.Domain.ValueString())
+
+	// Nothing to disassociate once the sending domain is gone
+	err := client.AssociateTrackingDomain(domain, subaccount, "")
+	if err != nil && err != DomainNotFound {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
 
 	resp.State.RemoveResource(ctx)
 }
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeTrackingDomainAPI serves the sending and tracking domain endpoints used
+// when deleting a tracking domain, recording the requests it receives
+type fakeTrackingDomainAPI struct {
+	mu             sync.Mutex
+	sendingDomains []TargetDomain
+	trackingDomain string
+	requests       []string
+}
+
+func (f *fakeTrackingDomainAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	request := r.Method + " " + r.URL.Path
+	if subaccount := r.Header.Get("X-MSYS-SUBACCOUNT"); subaccount != "" {
+		request += " subaccount=" + subaccount
+	}
+	f.requests = append(f.requests, request)
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/sending-domains":
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": f.sendingDomains})
+	case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/sending-domains/"):
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		name := strings.TrimPrefix(r.URL.Path, "/sending-domains/")
+		for i := range f.sendingDomains {
+			if f.sendingDomains[i].Domain == name {
+				f.sendingDomains[i].TrackingDomain = body["tracking_domain"]
+				json.NewEncoder(w).Encode(map[string]interface{}{"results": map[string]string{"message": "Successfully Updated Domain."}})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/tracking-domains/"):
+		if strings.TrimPrefix(r.URL.Path, "/tracking-domains/") != f.trackingDomain {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for _, d := range f.sendingDomains {
+			if d.TrackingDomain == f.trackingDomain {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+		}
+		f.trackingDomain = ""
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func trackingDomainTestState(t *testing.T, domain string, forceDisassociate bool) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewTrackingDomainResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	timeoutTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+	model := trackingDomainResourceModel{
+		Domain:             types.StringValue(domain),
+		HTTPS:              types.BoolNull(),
+		Port:               types.Int64Value(80),
+		Default:            types.BoolValue(false),
+		Subaccount:         types.Int64Null(),
+		SubaccountName:     types.StringNull(),
+		Id:                 types.StringValue(domain),
+		DNSRecords:         types.ListNull(types.ObjectType{AttrTypes: dnsRecordAttrTypes}),
+		DeletionProtection: types.BoolValue(false),
+		ForceDisassociate:  types.BoolValue(forceDisassociate),
+		Timeouts:           timeouts.Value{Object: types.ObjectNull(timeoutTypes)},
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+	return state
+}
+
+func deleteTrackingDomain(t *testing.T, api *fakeTrackingDomainAPI, forceDisassociate bool) resource.DeleteResponse {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	r := &trackingDomainResource{client: NewSparkPostClient(server.URL+"/", "key")}
+	state := trackingDomainTestState(t, "track.example.com", forceDisassociate)
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	return resp
+}
+
+func TestTrackingDomainDeleteForceDisassociate(t *testing.T) {
+	api := &fakeTrackingDomainAPI{
+		trackingDomain: "track.example.com",
+		sendingDomains: []TargetDomain{
+			{Domain: "mail.example.com", TrackingDomain: "track.example.com"},
+			{Domain: "tenant.example.com", TrackingDomain: "track.example.com", SubaccountID: 7},
+			{Domain: "other.example.com", TrackingDomain: "track.example.org"},
+		},
+	}
+
+	resp := deleteTrackingDomain(t, api, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from state")
+	}
+
+	expected := []string{
+		"GET /sending-domains",
+		"PUT /sending-domains/mail.example.com",
+		"PUT /sending-domains/tenant.example.com subaccount=7",
+		"DELETE /tracking-domains/track.example.com",
+	}
+	if strings.Join(api.requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected requests:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(api.requests, "\n"))
+	}
+	if api.sendingDomains[2].TrackingDomain != "track.example.org" {
+		t.Errorf("expected other tracking domains to be left alone")
+	}
+}
+
+func TestTrackingDomainDeleteInUse(t *testing.T) {
+	api := &fakeTrackingDomainAPI{
+		trackingDomain: "track.example.com",
+		sendingDomains: []TargetDomain{
+			{Domain: "mail.example.com", TrackingDomain: "track.example.com"},
+		},
+	}
+
+	resp := deleteTrackingDomain(t, api, false)
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Tracking Domain In Use" {
+		t.Fatalf("expected a Tracking Domain In Use error, got: %v", resp.Diagnostics)
+	}
+	if !strings.Contains(errs[0].Detail(), "'mail.example.com'") {
+		t.Errorf("expected the blocking sending domain to be listed, got: %s", errs[0].Detail())
+	}
+	if len(api.requests) != 1 {
+		t.Errorf("expected only the list request, got: %v", api.requests)
+	}
+}
+
+func TestTrackingDomainDeleteAlreadyDeleted(t *testing.T) {
+	api := &fakeTrackingDomainAPI{}
+
+	resp := deleteTrackingDomain(t, api, false)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from state")
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type userResource struct {
+	client *SparkPostClient
+}
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResourceModel struct {
+	Email             types.String   `tfsdk:"email"`
+	AccessLevel       types.String   `tfsdk:"access_level"`
+	Subaccount        types.Int64    `tfsdk:"subaccount"`
+	SubaccountName    types.String   `tfsdk:"subaccount_name"`
+	TFARequired       types.Bool     `tfsdk:"tfa_required"`
+	Username          types.String   `tfsdk:"username"`
+	InvitationPending types.Bool     `tfsdk:"invitation_pending"`
+	Id                types.String   `tfsdk:"id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invites a user to the account and manages their access once the invitation is accepted. Pending invitations cannot be revoked through the API and are only removed from state on destroy",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The email address the invitation is sent to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_level": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The role of the user, such as `admin`, `developer`, `templates`, `reporting`, `email`, `subaccount_reporting` or `subaccount_developer`",
+			},
+			"subaccount": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Optional subaccount ID the user is restricted to. Requires a `subaccount_` access level. Changing it replaces the user, as users cannot be moved between subaccounts. Cannot be used if subaccount_name is set",
+			},
+			"subaccount_name": subaccountNameAttribute(),
+			"tfa_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Optional to require two-factor authentication for the user. Applied once the invitation is accepted",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The username chosen by the user when accepting the invitation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_pending": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the invitation has not been accepted yet",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address used as the resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*SparkPostClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SparkPostClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *userResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		subaccountNameValidator{},
+	}
+}
+
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifySubaccountPlan(ctx, r.client, req, resp)
+}
+
+func (r *userResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config userResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Email.IsNull() && !config.Email.IsUnknown() {
+		if err := ValidateRecipientEmail(config.Email.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "Invalid Email", err.Error())
+		}
+	}
+
+	if config.AccessLevel.IsNull() || config.AccessLevel.IsUnknown() || config.Subaccount.IsUnknown() {
+		return
+	}
+
+	// Subaccount access levels and a subaccount restriction go together
+	restricted := !config.Subaccount.IsNull() || !config.SubaccountName.IsNull()
+	subaccountLevel := strings.HasPrefix(config.AccessLevel.ValueString(), "subaccount_")
+	if subaccountLevel && !restricted {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			fmt.Sprintf("'subaccount' or 'subaccount_name' must be set when 'access_level' is '%s'.", config.AccessLevel.ValueString()),
+		)
+	}
+	if !subaccountLevel && restricted {
+		resp.Diagnostics.AddError(
+			"Invalid Configuration",
+			"'subaccount' and 'subaccount_name' can only be set with a 'subaccount_' access level.",
+		)
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := plan.Email.ValueString()
+	subaccount := int(plan.Subaccount.ValueInt64())
+
+	err := client.InviteUser(email, plan.AccessLevel.ValueString(), subaccount)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", err.Error())
+		return
+	}
+
+	plan.Id = plan.Email
+	plan.Username = types.StringNull()
+	plan.InvitationPending = types.BoolValue(true)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := client.FindUserByEmail(state.Id.ValueString())
+	if err != nil {
+		if err == UserNotFound {
+			// An accepted user that no longer exists was removed outside of Terraform
+			if !state.InvitationPending.ValueBool() {
+				resp.State.RemoveResource(ctx)
+			}
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	state.Username = types.StringValue(user.Username)
+	state.InvitationPending = types.BoolValue(false)
+	state.AccessLevel = types.StringValue(user.AccessLevel)
+	state.TFARequired = types.BoolValue(user.TFARequired)
+	if user.SubaccountID > 0 {
+		state.Subaccount = types.Int64Value(int64(user.SubaccountID))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the access level and two-factor requirement are sent. Changes to
+	// the subaccount replace the user, so other changes such as timeouts or
+	// subaccount_name need no request
+	if plan.AccessLevel.Equal(state.AccessLevel) && plan.TFARequired.Equal(state.TFARequired) {
+		diags := resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if state.InvitationPending.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Update Error",
+			fmt.Sprintf("The invitation for '%s' has not been accepted yet. Changes can be applied once the user has signed up.", state.Email.ValueString()),
+		)
+		return
+	}
+
+	err := client.UpdateUser(state.Username.ValueString(), plan.AccessLevel.ValueString(), plan.TFARequired.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, client, cancel := withTimeout(ctx, r.client, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.InvitationPending.ValueBool() {
+		err := client.DeleteUser(state.Username.ValueString())
+		if err != nil && err != UserNotFound {
+			resp.Diagnostics.AddError("Delete Error", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
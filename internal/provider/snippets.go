@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type SnippetContent struct {
+	HTML    string `json:"html,omitempty"`
+	Text    string `json:"text,omitempty"`
+	AMPHTML string `json:"amp_html,omitempty"`
+}
+
+type Snippet struct {
+	ID      string         `json:"id,omitempty"`
+	Name    string         `json:"name"`
+	Content SnippetContent `json:"content"`
+	Shared  bool           `json:"shared_with_subaccounts"`
+}
+
+func (c *SparkPostClient) CreateSnippet(snippet Snippet, subaccount int) error {
+	req, err := c.newRequest("POST", "snippets", snippet)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("create snippet request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) GetSnippet(id string, subaccount int) (*Snippet, error) {
+	endpoint := fmt.Sprintf("snippets/%s", id)
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, SnippetNotFound
+		}
+		return nil, fmt.Errorf("get snippet request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results Snippet `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get snippet response: %w", err)
+	}
+
+	return &respBody.Results, nil
+}
+
+func (c *SparkPostClient) UpdateSnippet(snippet Snippet, subaccount int) error {
+	endpoint := fmt.Sprintf("snippets/%s", snippet.ID)
+
+	// The ID is taken from the endpoint and must not be sent in the body.
+	// Every content part is sent, with removed parts as null so they are
+	// cleared
+	body := map[string]interface{}{
+		"name": snippet.Name,
+		"content": map[string]*string{
+			"html":     nullIfEmpty(snippet.Content.HTML),
+			"text":     nullIfEmpty(snippet.Content.Text),
+			"amp_html": nullIfEmpty(snippet.Content.AMPHTML),
+		},
+		"shared_with_subaccounts": snippet.Shared,
+	}
+
+	req, err := c.newRequest("PUT", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update snippet request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) DeleteSnippet(id string, subaccount int) error {
+	endpoint := fmt.Sprintf("snippets/%s", id)
+
+	req, err := c.newRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return SnippetNotFound
+		}
+		return fmt.Errorf("delete snippet request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+var SnippetNotFound = fmt.Errorf("snippet not found")
+
+// nullIfEmpty returns nil for an empty value, which is sent as null
+func nullIfEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// SSOConfig is the SAML configuration of the account. Whether users must sign
+// in through SSO is an account option, see AccountOptions.EnforceSSO
+type SSOConfig struct {
+	Enabled     bool   `json:"enabled"`
+	DefaultRole string `json:"default_access_level,omitempty"`
+	// ClearDefaultRole sends a null default role to remove it when
+	// DefaultRole is empty
+	ClearDefaultRole bool `json:"-"`
+}
+
+func (c *SparkPostClient) UpdateSSOConfig(metadata string, config SSOConfig) error {
+	body := map[string]interface{}{
+		"cert":    base64.StdEncoding.EncodeToString([]byte(metadata)),
+		"enabled": config.Enabled,
+	}
+
+	if config.DefaultRole != "" {
+		body["default_access_level"] = config.DefaultRole
+	} else if config.ClearDefaultRole {
+		body["default_access_level"] = nil
+	}
+
+	req, err := c.newRequest("PUT", "account/saml", body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update SSO request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) GetSSOConfig() (*SSOConfig, error) {
+	req, err := c.newRequest("GET", "account/saml", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, SSOConfigNotFound
+		}
+		return nil, fmt.Errorf("get SSO request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results SSOConfig `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get SSO response: %w", err)
+	}
+
+	return &respBody.Results, nil
+}
+
+func (c *SparkPostClient) DeleteSSOConfig() error {
+	req, err := c.newRequest("DELETE", "account/saml", nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return SSOConfigNotFound
+		}
+		return fmt.Errorf("delete SSO request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+type samlEntityDescriptor struct {
+	XMLName          xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID         string   `xml:"entityID,attr"`
+	IDPSSODescriptor *struct {
+		KeyDescriptors []struct {
+			Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+		} `xml:"KeyDescriptor"`
+		SingleSignOnServices []struct {
+			Binding  string `xml:"Binding,attr"`
+			Location string `xml:"Location,attr"`
+		} `xml:"SingleSignOnService"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+// ValidateSAMLMetadata checks that the XML is SAML 2.0 identity provider
+// metadata with an entity ID, a single sign-on endpoint and a valid signing
+// certificate
+func ValidateSAMLMetadata(metadata string) error {
+	var descriptor samlEntityDescriptor
+	if err := xml.Unmarshal([]byte(metadata), &descriptor); err != nil {
+		return fmt.Errorf("metadata is not a SAML 2.0 EntityDescriptor: %w", err)
+	}
+
+	if descriptor.EntityID == "" {
+		return fmt.Errorf("metadata EntityDescriptor has no entityID")
+	}
+
+	idp := descriptor.IDPSSODescriptor
+	if idp == nil {
+		return fmt.Errorf("metadata does not contain an IDPSSODescriptor")
+	}
+
+	hasLocation := false
+	for _, service := range idp.SingleSignOnServices {
+		if strings.TrimSpace(service.Location) != "" {
+			hasLocation = true
+			break
+		}
+	}
+	if !hasLocation {
+		return fmt.Errorf("metadata does not contain a SingleSignOnService with a Location")
+	}
+
+	certificates := 0
+	for _, key := range idp.KeyDescriptors {
+		for _, encoded := range key.Certificates {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+			if err != nil {
+				return fmt.Errorf("metadata X509Certificate is not valid base64: %w", err)
+			}
+			if _, err := x509.ParseCertificate(der); err != nil {
+				return fmt.Errorf("metadata X509Certificate could not be parsed: %w", err)
+			}
+			certificates++
+		}
+	}
+	if certificates == 0 {
+		return fmt.Errorf("metadata does not contain an X509Certificate")
+	}
+
+	return nil
+}
+
+var SSOConfigNotFound = fmt.Errorf("SSO configuration not found")
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// subaccountNameAttribute is the schema of the subaccount_name attribute that
+// subaccount-aware resources accept as an alternative to subaccount.
+func subaccountNameAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Optional exact name of the subaccount, resolved to its ID when planning. Cannot be used if subaccount is set",
+	}
+}
+
+// subaccountNameValidator rejects configurations that set both subaccount and
+// subaccount_name.
+type subaccountNameValidator struct{}
+
+func (v subaccountNameValidator) Description(ctx context.Context) string {
+	return "'subaccount' and 'subaccount_name' cannot both be set"
+}
+
+func (v subaccountNameValidator) MarkdownDescription(ctx context.Context) string {
+	return "`subaccount` and `subaccount_name` cannot both be set"
+}
+
+func (v subaccountNameValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var subaccount types.Int64
+	var subaccountName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subaccount"), &subaccount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subaccount_name"), &subaccountName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !subaccount.IsNull() && !subaccountName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subaccount_name"),
+			"Invalid Configuration",
+			"'subaccount' and 'subaccount_name' cannot both be set. Please specify only one.",
+		)
+	}
+}
+
+// modifySubaccountPlan resolves subaccount_name into the planned subaccount
+// ID. Replacement is only required when the resolved ID changes, so switching
+// between subaccount and subaccount_name for the same subaccount is in place.
+func modifySubaccountPlan(ctx context.Context, client *SparkPostClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planSubaccount(ctx, client, types.Int64Null(), false, req, resp)
+}
+
+// modifyDefaultSubaccountPlan is modifySubaccountPlan for resources that send
+// their subaccount as the X-MSYS-SUBACCOUNT header. When neither subaccount nor
+// subaccount_name is set, a new resource is planned in the provider-level
+// subaccount and an existing one keeps the subaccount it was created in, so
+// changing the provider-level subaccount never replaces resources. Setting
+// subaccount to 0 opts out and uses the primary account. It reports whether
+// the planned subaccount was not set in the configuration.
+func modifyDefaultSubaccountPlan(ctx context.Context, client *SparkPostClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) bool {
+	fallback := types.Int64Null()
+	if client != nil && client.Subaccount != 0 {
+		fallback = types.Int64Value(int64(client.Subaccount))
+	}
+	return planSubaccount(ctx, client, fallback, true, req, resp)
+}
+
+// planSubaccount sets the planned subaccount from the configuration. When
+// neither subaccount nor subaccount_name is set, it falls back to the state if
+// keepState is set and the resource exists, or to fallback otherwise, and
+// reports whether a non-zero subaccount was planned that way.
+func planSubaccount(ctx context.Context, client *SparkPostClient, fallback types.Int64, keepState bool, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) bool {
+	// Nothing to resolve when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return false
+	}
+
+	var subaccount types.Int64
+	var subaccountName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subaccount"), &subaccount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subaccount_name"), &subaccountName)...)
+	if resp.Diagnostics.HasError() {
+		return false
+	}
+
+	current := types.Int64Null()
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("subaccount"), &current)...)
+		if resp.Diagnostics.HasError() {
+			return false
+		}
+	}
+
+	planned := subaccount
+	defaulted := false
+	if subaccount.IsNull() {
+		switch {
+		case subaccountName.IsNull():
+			planned = fallback
+			if keepState && !req.State.Raw.IsNull() {
+				planned = current
+			}
+			defaulted = !planned.IsNull() && planned.ValueInt64() != 0
+		case subaccountName.IsUnknown() || client == nil:
+			planned = types.Int64Unknown()
+		default:
+			sa, err := client.WithContext(ctx).FindSubaccountByName(subaccountName.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("subaccount_name"), "Subaccount Lookup Failed", err.Error())
+				return false
+			}
+			planned = types.Int64Value(int64(sa.ID))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("subaccount"), planned)...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return defaulted
+	}
+
+	// A null subaccount and 0 both mean the primary account
+	if planned.IsUnknown() || planned.ValueInt64() != current.ValueInt64() {
+		resp.RequiresReplace.Append(path.Root("subaccount"))
+	}
+
+	return defaulted
+}
+
+// validateSharedWithDefaultSubaccount rejects shared_with_subaccounts when the
+// planned subaccount was not set in the configuration, as a resource in a
+// subaccount cannot be shared
+func validateSharedWithDefaultSubaccount(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var shared types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shared_with_subaccounts"), &shared)...)
+	if !shared.IsNull() && !shared.IsUnknown() && shared.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("shared_with_subaccounts"),
+			"Invalid Configuration",
+			"'shared_with_subaccounts = true' cannot be used in a subaccount, which here comes from the provider-level 'subaccount' or the existing resource. Set 'subaccount = 0' to use the primary account.",
+		)
+	}
+}
@@ -3,11 +3,52 @@
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
+type SubaccountSendingLimits struct {
+	Daily   *int `json:"daily,omitempty"`
+	Monthly *int `json:"monthly,omitempty"`
+	// ClearDaily and ClearMonthly send a null limit to remove it when the
+	// limit itself is nil
+	ClearDaily   bool `json:"-"`
+	ClearMonthly bool `json:"-"`
+}
+
+func (l SubaccountSendingLimits) MarshalJSON() ([]byte, error) {
+	limits := map[string]*int{}
+	if l.Daily != nil || l.ClearDaily {
+		limits["daily"] = l.Daily
+	}
+	if l.Monthly != nil || l.ClearMonthly {
+		limits["monthly"] = l.Monthly
+	}
+	return json.Marshal(limits)
+}
+
+type SubaccountOptions struct {
+	SendingLimits *SubaccountSendingLimits `json:"sending_limits,omitempty"`
+}
+
 type Subaccount struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID               int               `json:"id"`
+	Name             string            `json:"name"`
+	Status           string            `json:"status"`
+	ComplianceStatus string            `json:"compliance_status"`
+	IPPool           string            `json:"ip_pool"`
+	Created          string            `json:"created,omitempty"`
+	Options          SubaccountOptions `json:"options"`
+}
+
+// SubaccountUpdate holds the subaccount settings to change. Nil fields are
+// left unchanged.
+type SubaccountUpdate struct {
+	Name    *string            `json:"name,omitempty"`
+	Status  *string            `json:"status,omitempty"`
+	IPPool  *string            `json:"ip_pool,omitempty"`
+	Options *SubaccountOptions `json:"options,omitempty"`
 }
 
 func (c *SparkPostClient) ListSubaccounts() ([]Subaccount, error) {	
@@ -30,4 +71,97 @@ func (c *SparkPostClient) ListSubaccounts() ([]Subaccount, error) {
 	}
 
 	return body.Results, nil
-}
\ No newline at end of file
+}
+
+func (c *SparkPostClient) GetSubaccount(id int) (*Subaccount, error) {
+	endpoint := fmt.Sprintf("subaccounts/%d", id)
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, SubaccountNotFound
+		}
+		return nil, fmt.Errorf("get subaccount request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Results Subaccount `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode subaccount: %w", err)
+	}
+
+	return &body.Results, nil
+}
+
+func (c *SparkPostClient) UpdateSubaccount(id int, update SubaccountUpdate) error {
+	endpoint := fmt.Sprintf("subaccounts/%d", id)
+
+	req, err := c.newRequest("PUT", endpoint, update)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return SubaccountNotFound
+		}
+		return fmt.Errorf("update subaccount request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+var SubaccountNotFound = fmt.Errorf("subaccount not found")
+
+// FindSubaccountByName returns the only subaccount with exactly the given name
+func (c *SparkPostClient) FindSubaccountByName(name string) (*Subaccount, error) {
+	subaccounts, err := c.ListSubaccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Subaccount
+	for _, sa := range subaccounts {
+		if sa.Name == name {
+			matches = append(matches, sa)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no subaccount is named '%s'", name)
+	case 1:
+		return &matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, sa := range matches {
+		ids = append(ids, strconv.Itoa(sa.ID))
+	}
+	return nil, fmt.Errorf("%d subaccounts are named '%s' (IDs %s). Use 'subaccount' with the ID instead", len(matches), name, strings.Join(ids, ", "))
+}
+
+// FilterSubaccounts returns the subaccounts whose name matches nameRegex and
+// whose status equals status. A nil nameRegex or empty status matches all
+func FilterSubaccounts(subaccounts []Subaccount, nameRegex *regexp.Regexp, status string) []Subaccount {
+	var matches []Subaccount
+	for _, sa := range subaccounts {
+		if nameRegex != nil && !nameRegex.MatchString(sa.Name) {
+			continue
+		}
+		if status != "" && sa.Status != status {
+			continue
+		}
+		matches = append(matches, sa)
+	}
+	return matches
+}
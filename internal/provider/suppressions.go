@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Maximum number of entries sent in a single suppression list PUT
+const suppressionBatchSize = 1000
+
+// Number of entries requested per page when searching the suppression list
+const suppressionSearchPageSize = 1000
+
+type Suppression struct {
+	Recipient   string `json:"recipient"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Source      string `json:"source,omitempty"`
+	Created     string `json:"created,omitempty"`
+	Updated     string `json:"updated,omitempty"`
+}
+
+// SuppressionSearch holds the filters for searching the suppression list.
+// Empty fields are not sent.
+type SuppressionSearch struct {
+	From        string
+	To          string
+	Domain      string
+	Description string
+	Types       []string
+	Sources     []string
+}
+
+func (c *SparkPostClient) PutSuppressions(entries []Suppression, subaccount int) error {
+	for start := 0; start < len(entries); start += suppressionBatchSize {
+		end := start + suppressionBatchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		// Only the recipient, type and description can be set. The
+		// description is always sent so an empty one clears it
+		batch := make([]map[string]string, 0, end-start)
+		for _, entry := range entries[start:end] {
+			batch = append(batch, map[string]string{
+				"recipient":   entry.Recipient,
+				"type":        entry.Type,
+				"description": entry.Description,
+			})
+		}
+
+		body := map[string]interface{}{
+			"recipients": batch,
+		}
+
+		req, err := c.newRequest("PUT", "suppression-list", body)
+		if err != nil {
+			return fmt.Errorf("failed to build request: %w", err)
+		}
+
+		if subaccount > 0 {
+			req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+		}
+
+		resp, err := c.doRequest(req, 200)
+		if err != nil {
+			return fmt.Errorf("suppression list request failed for entries %d-%d: %w", start+1, end, err)
+		}
+		resp.Body.Close()
+	}
+
+	return nil
+}
+
+func (c *SparkPostClient) GetSuppressions(recipient string, subaccount int) ([]Suppression, error) {
+	endpoint := fmt.Sprintf("suppression-list/%s", url.PathEscape(recipient))
+
+	req, err := c.newRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, SuppressionNotFound
+		}
+		return nil, fmt.Errorf("get suppression request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Results []Suppression `json:"results"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("failed to parse get suppression response: %w", err)
+	}
+
+	return respBody.Results, nil
+}
+
+// SearchSuppressions returns all entries matching the search, following the
+// cursor links until every page has been fetched
+func (c *SparkPostClient) SearchSuppressions(search SuppressionSearch, subaccount int) ([]Suppression, error) {
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(suppressionSearchPageSize))
+	if search.From != "" {
+		params.Set("from", search.From)
+	}
+	if search.To != "" {
+		params.Set("to", search.To)
+	}
+	if search.Domain != "" {
+		params.Set("domain", search.Domain)
+	}
+	if search.Description != "" {
+		params.Set("description", search.Description)
+	}
+	if len(search.Types) > 0 {
+		params.Set("types", strings.Join(search.Types, ","))
+	}
+	if len(search.Sources) > 0 {
+		params.Set("sources", strings.Join(search.Sources, ","))
+	}
+
+	var results []Suppression
+	cursor := "initial"
+	for cursor != "" {
+		params.Set("cursor", cursor)
+
+		req, err := c.newRequest("GET", "suppression-list?"+params.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request: %w", err)
+		}
+
+		if subaccount > 0 {
+			req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+		}
+
+		resp, err := c.doRequest(req, 200)
+		if err != nil {
+			return nil, fmt.Errorf("suppression search request failed: %w", err)
+		}
+
+		var respBody struct {
+			Results []Suppression `json:"results"`
+			Links   struct {
+				Next string `json:"next"`
+			} `json:"links"`
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&respBody)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse suppression search response: %w", err)
+		}
+
+		results = append(results, respBody.Results...)
+
+		next := ""
+		if respBody.Links.Next != "" && len(respBody.Results) > 0 {
+			nextURL, err := url.Parse(respBody.Links.Next)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse suppression search next link: %w", err)
+			}
+			next = nextURL.Query().Get("cursor")
+		}
+		if next == cursor {
+			return nil, fmt.Errorf("suppression search returned the same cursor twice")
+		}
+		cursor = next
+	}
+
+	return results, nil
+}
+
+// GetSuppression returns the entry for a recipient with the given type
+func (c *SparkPostClient) GetSuppression(recipient string, suppressionType string, subaccount int) (*Suppression, error) {
+	entries, err := c.GetSuppressions(recipient, subaccount)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Type == suppressionType {
+			return &entry, nil
+		}
+	}
+
+	return nil, SuppressionNotFound
+}
+
+func (c *SparkPostClient) DeleteSuppression(recipient string, suppressionType string, subaccount int) error {
+	endpoint := fmt.Sprintf("suppression-list/%s", url.PathEscape(recipient))
+
+	body := map[string]interface{}{
+		"type": suppressionType,
+	}
+
+	req, err := c.newRequest("DELETE", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if subaccount > 0 {
+		req.Header.Set("X-MSYS-SUBACCOUNT", strconv.Itoa(subaccount))
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return SuppressionNotFound
+		}
+		return fmt.Errorf("delete suppression request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ValidateSuppressionType checks the type against the values SparkPost accepts
+func ValidateSuppressionType(suppressionType string) error {
+	switch suppressionType {
+	case "transactional", "non_transactional":
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid suppression type. Must be 'transactional' or 'non_transactional'", suppressionType)
+}
+
+var SuppressionNotFound = fmt.Errorf("suppression entry not found")
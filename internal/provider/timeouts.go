@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultTimeout bounds resource operations without a configured timeout
+const defaultTimeout = 10 * time.Minute
+
+// requestTimeout bounds each API request, including plan-time lookups and
+// data sources that have no operation timeout
+const requestTimeout = 2 * time.Minute
+
+// withTimeout bounds ctx by the operation timeout that timeout reads from the
+// configured timeouts, such as plan.Timeouts.Create, and returns the context,
+// a client whose requests use it and the func releasing it. The func must be
+// called even when the timeout couldn't be read, which is added to diags
+func withTimeout(ctx context.Context, client *SparkPostClient, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, *SparkPostClient, context.CancelFunc) {
+	duration, d := timeout(ctx, defaultTimeout)
+	diags.Append(d...)
+	if d.HasError() {
+		return ctx, client, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	return ctx, client.WithContext(ctx), cancel
+}
@@ -6,15 +6,43 @@ import (
 	"strconv"
 )
 
+type TrackingDomainStatus struct {
+	Verified         bool   `json:"verified"`
+	CNAMEStatus      string `json:"cname_status"`
+	ComplianceStatus string `json:"compliance_status"`
+}
+
 type TrackingDomain struct {
-	Domain string `json:"domain"`
+	Domain       string               `json:"domain"`
+	Port         int                  `json:"port"`
+	Secure       bool                 `json:"secure"`
+	Default      bool                 `json:"default"`
+	SubaccountID int                  `json:"subaccount_id,omitempty"`
+	Status       TrackingDomainStatus `json:"status"`
+}
+
+// TrackingDomainOptions holds the optional tracking domain settings. A zero
+// Port and nil Default are not sent, leaving the SparkPost defaults in place
+type TrackingDomainOptions struct {
+	Port    int
+	Default *bool
 }
 
-func (c *SparkPostClient) CreateTrackingDomain(domain string, https bool, subaccount int) error {
+func (o TrackingDomainOptions) addTo(body map[string]interface{}) {
+	if o.Port > 0 {
+		body["port"] = o.Port
+	}
+	if o.Default != nil {
+		body["default"] = *o.Default
+	}
+}
+
+func (c *SparkPostClient) CreateTrackingDomain(domain string, https bool, options TrackingDomainOptions, subaccount int) error {
 	body := map[string]interface{}{
 		"domain": domain,
 		"secure": https,
 	}
+	options.addTo(body)
 
 	req, err := c.newRequest("POST", "tracking-domains", body)
 	if err != nil {
@@ -48,17 +76,22 @@ func (c *SparkPostClient) GetTrackingDomain(domain string, subaccount int) (*Tra
 
 	resp, err := c.doRequest(req, 200)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, TrackingDomainNotFound
+		}
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var trackingDomain TrackingDomain
-	err = json.NewDecoder(resp.Body).Decode(&trackingDomain)
+	var respBody struct {
+		Results TrackingDomain `json:"results"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&respBody)
 	if err != nil {
 		return nil, err
 	}
 
-	return &trackingDomain, nil
+	return &respBody.Results, nil
 }
 
 func (c *SparkPostClient) DeleteTrackingDomain(domain string, subaccount int) error {
@@ -75,22 +108,21 @@ func (c *SparkPostClient) DeleteTrackingDomain(domain string, subaccount int) er
 
 	resp, err := c.doRequest(req, 204)
 	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return TrackingDomainNotFound
+		}
 		return err
 	}
-
-	if resp.StatusCode == 404 {
-		return TrackingDomainNotFound
-	}
-
 	defer resp.Body.Close()
 
 	return nil
 }
 
-func (c *SparkPostClient) UpdateTrackingDomain(domain string, https bool, subaccount int) error {
+func (c *SparkPostClient) UpdateTrackingDomain(domain string, https bool, options TrackingDomainOptions, subaccount int) error {
 	body := map[string]interface{}{
 		"secure": https,
 	}
+	options.addTo(body)
 
     endpoint := fmt.Sprintf("tracking-domains/%s", domain)
 
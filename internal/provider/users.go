@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type User struct {
+	Username     string `json:"username"`
+	Email        string `json:"email"`
+	AccessLevel  string `json:"access_level"`
+	SubaccountID int    `json:"subaccount_id,omitempty"`
+	TFAEnabled   bool   `json:"tfa_enabled"`
+	TFARequired  bool   `json:"tfa_required"`
+	LastLogin    string `json:"last_login,omitempty"`
+}
+
+func (c *SparkPostClient) ListUsers() ([]User, error) {
+	req, err := c.newRequest("GET", "users", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return nil, fmt.Errorf("users request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Results []User `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode users: %w", err)
+	}
+
+	return body.Results, nil
+}
+
+// FindUserByEmail returns the user with the given email address, or
+// UserNotFound if no user has accepted an invitation for it yet
+func (c *SparkPostClient) FindUserByEmail(email string) (*User, error) {
+	users, err := c.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return &user, nil
+		}
+	}
+
+	return nil, UserNotFound
+}
+
+func (c *SparkPostClient) InviteUser(email string, accessLevel string, subaccount int) error {
+	body := map[string]interface{}{
+		"email":        email,
+		"access_level": accessLevel,
+	}
+
+	if subaccount > 0 {
+		body["subaccount_id"] = subaccount
+	}
+
+	req, err := c.newRequest("POST", "users/invite", body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("invite user request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) UpdateUser(username string, accessLevel string, tfaRequired bool) error {
+	endpoint := fmt.Sprintf("users/%s", username)
+
+	body := map[string]interface{}{
+		"access_level": accessLevel,
+		"tfa_required": tfaRequired,
+	}
+
+	req, err := c.newRequest("PUT", endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 200)
+	if err != nil {
+		return fmt.Errorf("update user request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *SparkPostClient) DeleteUser(username string) error {
+	endpoint := fmt.Sprintf("users/%s", username)
+
+	req, err := c.newRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	resp, err := c.doRequest(req, 204)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return UserNotFound
+		}
+		return fmt.Errorf("delete user request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+var UserNotFound = fmt.Errorf("user not found")